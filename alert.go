@@ -0,0 +1,116 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/alert"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) Alert(kind alert.Kind, message string, opts ...alert.Option) {
+	alertOpts := &options.AlertOptions{
+		Kind:        kind.String(),
+		Message:     message,
+		Title:       "",
+		Icon:        "",
+		Dismissible: false,
+	}
+
+	for _, o := range opts {
+		o.Apply(alertOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeAlert, path)
+	alertState := sess.State.GetAlert(widgetID)
+	if alertState == nil {
+		alertState = &state.AlertState{
+			ID: widgetID,
+		}
+	}
+	alertState.Kind = alertOpts.Kind
+	alertState.Message = alertOpts.Message
+	alertState.Title = alertOpts.Title
+	alertState.Icon = alertOpts.Icon
+	alertState.Dismissible = alertOpts.Dismissible
+	if !alertState.Dismissible {
+		alertState.Dismissed = false
+	}
+	sess.State.Set(widgetID, alertState)
+
+	alertProto := convertStateToAlertProto(alertState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Alert{
+				Alert: alertProto,
+			},
+		},
+	})
+
+	cursor.next()
+}
+
+func (b *uiBuilder) Info(message string, opts ...alert.Option) {
+	b.Alert(alert.KindInfo, message, opts...)
+}
+
+func (b *uiBuilder) Success(message string, opts ...alert.Option) {
+	b.Alert(alert.KindSuccess, message, opts...)
+}
+
+func (b *uiBuilder) Warning(message string, opts ...alert.Option) {
+	b.Alert(alert.KindWarning, message, opts...)
+}
+
+func (b *uiBuilder) Error(message string, opts ...alert.Option) {
+	b.Alert(alert.KindError, message, opts...)
+}
+
+func convertStateToAlertProto(state *state.AlertState) *widgetv1.Alert {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Alert{
+		Kind:        state.Kind,
+		Message:     state.Message,
+		Title:       state.Title,
+		Icon:        state.Icon,
+		Dismissible: state.Dismissible,
+		Dismissed:   state.Dismissed,
+	}
+}
+
+func convertAlertProtoToState(id uuid.UUID, data *widgetv1.Alert) *state.AlertState {
+	if data == nil {
+		return nil
+	}
+	return &state.AlertState{
+		ID:          id,
+		Kind:        data.Kind,
+		Message:     data.Message,
+		Title:       data.Title,
+		Icon:        data.Icon,
+		Dismissible: data.Dismissible,
+		Dismissed:   data.Dismissed,
+	}
+}
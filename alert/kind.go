@@ -0,0 +1,14 @@
+package alert
+
+type Kind string
+
+const (
+	KindInfo    Kind = "info"
+	KindSuccess Kind = "success"
+	KindWarning Kind = "warning"
+	KindError   Kind = "error"
+)
+
+func (k Kind) String() string {
+	return string(k)
+}
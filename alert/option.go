@@ -0,0 +1,37 @@
+package alert
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.AlertOptions)
+}
+
+type titleOption string
+
+func (t titleOption) Apply(opts *options.AlertOptions) {
+	opts.Title = string(t)
+}
+
+func WithTitle(title string) Option {
+	return titleOption(title)
+}
+
+type iconOption string
+
+func (i iconOption) Apply(opts *options.AlertOptions) {
+	opts.Icon = string(i)
+}
+
+func WithIcon(icon string) Option {
+	return iconOption(icon)
+}
+
+type dismissibleOption bool
+
+func (d dismissibleOption) Apply(opts *options.AlertOptions) {
+	opts.Dismissible = bool(d)
+}
+
+func WithDismissible(dismissible bool) Option {
+	return dismissibleOption(dismissible)
+}
@@ -0,0 +1,196 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/alert"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToAlertProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+
+	alertState := &state.AlertState{
+		ID:          id,
+		Kind:        alert.KindWarning.String(),
+		Message:     "Disk almost full",
+		Title:       "Warning",
+		Icon:        "alert-triangle",
+		Dismissible: true,
+		Dismissed:   true,
+	}
+
+	data := convertStateToAlertProto(alertState)
+
+	if data == nil {
+		t.Fatal("convertStateToAlertProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Kind", data.Kind, alertState.Kind},
+		{"Message", data.Message, alertState.Message},
+		{"Title", data.Title, alertState.Title},
+		{"Icon", data.Icon, alertState.Icon},
+		{"Dismissible", data.Dismissible, alertState.Dismissible},
+		{"Dismissed", data.Dismissed, alertState.Dismissed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertAlertProtoToState(t *testing.T) {
+	data := &widgetv1.Alert{
+		Kind:        alert.KindError.String(),
+		Message:     "Sync failed",
+		Title:       "Error",
+		Icon:        "x-circle",
+		Dismissible: true,
+		Dismissed:   true,
+	}
+
+	state := convertAlertProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertAlertProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Kind", state.Kind, data.Kind},
+		{"Message", state.Message, data.Message},
+		{"Title", state.Title, data.Title},
+		{"Icon", state.Icon, data.Icon},
+		{"Dismissible", state.Dismissible, data.Dismissible},
+		{"Dismissed", state.Dismissed, data.Dismissed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlert(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	message := "Saved successfully"
+	builder.Alert(alert.KindSuccess, message,
+		alert.WithTitle("Done"),
+		alert.WithIcon("check"),
+		alert.WithDismissible(true),
+	)
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if v := msg.GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeAlert, []int{0})
+	state := sess.State.GetAlert(widgetID)
+	if state == nil {
+		t.Fatal("Alert state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Kind", state.Kind, alert.KindSuccess.String()},
+		{"Message", state.Message, message},
+		{"Title", state.Title, "Done"},
+		{"Icon", state.Icon, "check"},
+		{"Dismissible", state.Dismissible, true},
+		{"Dismissed", state.Dismissed, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAlert_Shorthands(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	builder.Info("info")
+	builder.Success("success")
+	builder.Warning("warning")
+	builder.Error("error")
+
+	want := []alert.Kind{alert.KindInfo, alert.KindSuccess, alert.KindWarning, alert.KindError}
+	for i, kind := range want {
+		widgetID := builder.generatePageID(state.WidgetTypeAlert, []int{i})
+		state := sess.State.GetAlert(widgetID)
+		if state == nil {
+			t.Fatalf("Alert state %d not found", i)
+		}
+		if state.Kind != kind.String() {
+			t.Errorf("Kind = %v, want %v", state.Kind, kind)
+		}
+		if state.Dismissible {
+			t.Error("Default Dismissible = true, want false")
+		}
+	}
+}
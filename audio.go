@@ -0,0 +1,104 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/audio"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) Audio(src any, opts ...audio.Option) error {
+	audioOpts := &options.AudioOptions{
+		Caption:  "",
+		MimeType: "",
+		Autoplay: false,
+		Loop:     false,
+	}
+
+	for _, o := range opts {
+		o.Apply(audioOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return nil
+	}
+	page := b.page
+	if page == nil {
+		return nil
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return nil
+	}
+	path := cursor.getPath()
+
+	url, mediaID, mimeType, err := b.resolveMediaSource(src, audioOpts.MimeType)
+	if err != nil {
+		return err
+	}
+
+	widgetID := b.generatePageID(state.WidgetTypeAudio, path)
+	audioState := sess.State.GetAudio(widgetID)
+	if audioState == nil {
+		audioState = &state.AudioState{
+			ID: widgetID,
+		}
+	}
+	audioState.Src = url
+	audioState.MediaID = mediaID
+	audioState.MimeType = mimeType
+	audioState.Caption = audioOpts.Caption
+	audioState.Autoplay = audioOpts.Autoplay
+	audioState.Loop = audioOpts.Loop
+	sess.State.Set(widgetID, audioState)
+
+	audioProto := convertStateToAudioProto(audioState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Audio{
+				Audio: audioProto,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return nil
+}
+
+func convertStateToAudioProto(state *state.AudioState) *widgetv1.Audio {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Audio{
+		Src:      state.Src,
+		MediaId:  state.MediaID,
+		MimeType: state.MimeType,
+		Caption:  state.Caption,
+		Autoplay: state.Autoplay,
+		Loop:     state.Loop,
+	}
+}
+
+func convertAudioProtoToState(id uuid.UUID, data *widgetv1.Audio) *state.AudioState {
+	if data == nil {
+		return nil
+	}
+	return &state.AudioState{
+		ID:       id,
+		Src:      data.Src,
+		MediaID:  data.MediaId,
+		MimeType: data.MimeType,
+		Caption:  data.Caption,
+		Autoplay: data.Autoplay,
+		Loop:     data.Loop,
+	}
+}
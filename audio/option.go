@@ -0,0 +1,47 @@
+package audio
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.AudioOptions)
+}
+
+type captionOption string
+
+func (c captionOption) Apply(opts *options.AudioOptions) {
+	opts.Caption = string(c)
+}
+
+func WithCaption(caption string) Option {
+	return captionOption(caption)
+}
+
+type mimeTypeOption string
+
+func (m mimeTypeOption) Apply(opts *options.AudioOptions) {
+	opts.MimeType = string(m)
+}
+
+func WithMimeType(mimeType string) Option {
+	return mimeTypeOption(mimeType)
+}
+
+type autoplayOption bool
+
+func (a autoplayOption) Apply(opts *options.AudioOptions) {
+	opts.Autoplay = bool(a)
+}
+
+func WithAutoplay(autoplay bool) Option {
+	return autoplayOption(autoplay)
+}
+
+type loopOption bool
+
+func (l loopOption) Apply(opts *options.AudioOptions) {
+	opts.Loop = bool(l)
+}
+
+func WithLoop(loop bool) Option {
+	return loopOption(loop)
+}
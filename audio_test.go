@@ -0,0 +1,143 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/audio"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToAudioProto(t *testing.T) {
+	audioState := &state.AudioState{
+		ID:       uuid.Must(uuid.NewV4()),
+		Src:      "https://example.com/alert.mp3",
+		MimeType: "audio/mpeg",
+		Caption:  "Alert",
+		Autoplay: true,
+		Loop:     true,
+	}
+
+	data := convertStateToAudioProto(audioState)
+
+	if data == nil {
+		t.Fatal("convertStateToAudioProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Src", data.Src, audioState.Src},
+		{"MimeType", data.MimeType, audioState.MimeType},
+		{"Caption", data.Caption, audioState.Caption},
+		{"Autoplay", data.Autoplay, audioState.Autoplay},
+		{"Loop", data.Loop, audioState.Loop},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertAudioProtoToState(t *testing.T) {
+	data := &widgetv1.Audio{
+		MediaId:  "abc",
+		MimeType: "audio/wav",
+		Caption:  "Alert",
+		Loop:     true,
+	}
+
+	state := convertAudioProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertAudioProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"MediaID", state.MediaID, data.MediaId},
+		{"MimeType", state.MimeType, data.MimeType},
+		{"Caption", state.Caption, data.Caption},
+		{"Loop", state.Loop, data.Loop},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAudio(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	src := "https://example.com/alert.mp3"
+	if err := builder.Audio(src, audio.WithCaption("Alert"), audio.WithAutoplay(true)); err != nil {
+		t.Fatalf("Audio returned error: %v", err)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	if v := messages[0].GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeAudio, []int{0})
+	state := sess.State.GetAudio(widgetID)
+	if state == nil {
+		t.Fatal("Audio state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Src", state.Src, src},
+		{"Caption", state.Caption, "Alert"},
+		{"Autoplay", state.Autoplay, true},
+		{"Loop", state.Loop, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
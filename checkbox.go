@@ -1,6 +1,8 @@
 package sourcetool
 
 import (
+	"strconv"
+
 	"github.com/gofrs/uuid/v5"
 
 	"github.com/trysourcetool/sourcetool-go/checkbox"
@@ -43,6 +45,11 @@ func (b *uiBuilder) Checkbox(label string, opts ...checkbox.Option) bool {
 			ID:    widgetID,
 			Value: checkboxOpts.DefaultValue,
 		}
+		if v, ok := b.lookupQueryParam(checkboxOpts.QueryParam); ok {
+			if checked, err := strconv.ParseBool(v[0]); err == nil {
+				checkboxState.Value = checked
+			}
+		}
 	}
 	checkboxState.Label = checkboxOpts.Label
 	checkboxState.DefaultValue = checkboxOpts.DefaultValue
@@ -50,6 +57,8 @@ func (b *uiBuilder) Checkbox(label string, opts ...checkbox.Option) bool {
 	checkboxState.Disabled = checkboxOpts.Disabled
 	sess.State.Set(widgetID, checkboxState)
 
+	b.bindQueryParam(checkboxOpts.QueryParam, []string{strconv.FormatBool(checkboxState.Value)}, checkboxState.Value == checkboxOpts.DefaultValue)
+
 	checkboxProto := convertStateToCheckboxProto(checkboxState)
 	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
 		SessionId: sess.ID.String(),
@@ -35,3 +35,15 @@ func (d disabledOption) Apply(opts *options.CheckboxOptions) {
 func WithDisabled(disabled bool) Option {
 	return disabledOption(disabled)
 }
+
+type queryParamOption string
+
+func (q queryParamOption) Apply(opts *options.CheckboxOptions) {
+	opts.QueryParam = string(q)
+}
+
+// WithQueryParam binds the value to the key query parameter of the page URL.
+// The parameter sets the initial value and is updated when the value changes.
+func WithQueryParam(key string) Option {
+	return queryParamOption(key)
+}
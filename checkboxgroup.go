@@ -1,6 +1,8 @@
 package sourcetool
 
 import (
+	"slices"
+
 	"github.com/gofrs/uuid/v5"
 
 	"github.com/trysourcetool/sourcetool-go/checkboxgroup"
@@ -56,6 +58,14 @@ func (b *uiBuilder) CheckboxGroup(label string, opts ...checkboxgroup.Option) *c
 			ID:    widgetID,
 			Value: defaultVal,
 		}
+		if v, ok := b.lookupQueryParam(checkboxGroupOpts.QueryParam); ok {
+			checkboxGroupState.Value = nil
+			for _, s := range v {
+				if idx := slices.Index(checkboxGroupOpts.Options, s); idx >= 0 {
+					checkboxGroupState.Value = append(checkboxGroupState.Value, int32(idx))
+				}
+			}
+		}
 	}
 	if checkboxGroupOpts.FormatFunc == nil {
 		checkboxGroupOpts.FormatFunc = func(v string, i int) string {
@@ -75,6 +85,12 @@ func (b *uiBuilder) CheckboxGroup(label string, opts ...checkboxgroup.Option) *c
 	checkboxGroupState.Disabled = checkboxGroupOpts.Disabled
 	sess.State.Set(widgetID, checkboxGroupState)
 
+	values := make([]string, len(checkboxGroupState.Value))
+	for i, idx := range checkboxGroupState.Value {
+		values[i] = checkboxGroupOpts.Options[idx]
+	}
+	b.bindQueryParam(checkboxGroupOpts.QueryParam, values, slices.Equal(values, checkboxGroupOpts.DefaultValue))
+
 	checkboxGroupProto := convertStateToCheckboxGroupProto(checkboxGroupState)
 	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
 		SessionId: sess.ID.String(),
@@ -57,3 +57,16 @@ func (f formatFuncOption) Apply(opts *options.CheckboxGroupOptions) {
 func WithFormatFunc(formatFunc func(string, int) string) Option {
 	return formatFuncOption(formatFunc)
 }
+
+type queryParamOption string
+
+func (q queryParamOption) Apply(opts *options.CheckboxGroupOptions) {
+	opts.QueryParam = string(q)
+}
+
+// WithQueryParam binds the value to the key query parameter of the page URL.
+// The parameter sets the initial value and is updated when the value changes.
+// Several checked options are written as repeated parameters.
+func WithQueryParam(key string) Option {
+	return queryParamOption(key)
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/trysourcetool/sourcetool-go"
+)
+
+// diffManifests lists the pages added, removed and changed between two
+// manifests. Pages are matched by route because page IDs depend on the
+// environment of the API key.
+func diffManifests(before, after []sourcetool.PageManifest) []string {
+	beforeByRoute := make(map[string]sourcetool.PageManifest, len(before))
+	for _, p := range before {
+		beforeByRoute[p.Route] = p
+	}
+	afterByRoute := make(map[string]sourcetool.PageManifest, len(after))
+	for _, p := range after {
+		afterByRoute[p.Route] = p
+	}
+
+	var lines []string
+	for _, p := range before {
+		if _, ok := afterByRoute[p.Route]; !ok {
+			lines = append(lines, fmt.Sprintf("- %s (%s)", p.Route, p.Name))
+		}
+	}
+	for _, p := range after {
+		o, ok := beforeByRoute[p.Route]
+		if !ok {
+			lines = append(lines, fmt.Sprintf("+ %s (%s) groups=%v", p.Route, p.Name, p.Groups))
+			continue
+		}
+		for _, c := range diffPage(o, p) {
+			lines = append(lines, fmt.Sprintf("~ %s: %s", p.Route, c))
+		}
+	}
+	return lines
+}
+
+func diffPage(before, after sourcetool.PageManifest) []string {
+	var changes []string
+	field := func(name string, b, a any) {
+		if !reflect.DeepEqual(b, a) {
+			changes = append(changes, fmt.Sprintf("%s %v -> %v", name, b, a))
+		}
+	}
+	field("name", before.Name, after.Name)
+	field("path", before.Path, after.Path)
+	field("groups", sorted(before.Groups), sorted(after.Groups))
+	field("order", orderString(before.Order), orderString(after.Order))
+	field("hidden", before.Hidden, after.Hidden)
+	field("groupLabel", before.GroupLabel, after.GroupLabel)
+	field("icon", before.Icon, after.Icon)
+	field("description", before.Description, after.Description)
+	return changes
+}
+
+func sorted(s []string) []string {
+	s = slices.Clone(s)
+	slices.Sort(s)
+	if s == nil {
+		s = []string{}
+	}
+	return s
+}
+
+func orderString(order *int) string {
+	if order == nil {
+		return "none"
+	}
+	return fmt.Sprint(*order)
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/trysourcetool/sourcetool-go"
+)
+
+func TestDiffManifests(t *testing.T) {
+	order := 1
+	before := []sourcetool.PageManifest{
+		{Route: "/users", Name: "Users", Path: []int{0}, Groups: []string{"admin"}},
+		{Route: "/reports", Name: "Reports", Path: []int{1}, Groups: []string{}},
+	}
+
+	tests := []struct {
+		name  string
+		after []sourcetool.PageManifest
+		want  []string
+	}{
+		{
+			name:  "Unchanged",
+			after: before,
+			want:  nil,
+		},
+		{
+			name: "Added and removed",
+			after: []sourcetool.PageManifest{
+				before[0],
+				{Route: "/billing", Name: "Billing", Path: []int{1}, Groups: []string{"finance"}},
+			},
+			want: []string{
+				"- /reports (Reports)",
+				"+ /billing (Billing) groups=[finance]",
+			},
+		},
+		{
+			name: "Changed",
+			after: []sourcetool.PageManifest{
+				{Route: "/users", Name: "Users", Path: []int{0}, Groups: []string{"admin", "support"}, Order: &order},
+				before[1],
+			},
+			want: []string{
+				"~ /users: groups [admin] -> [admin support]",
+				"~ /users: order none -> 1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffManifests(before, tt.after)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffManifests() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
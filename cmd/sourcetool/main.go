@@ -0,0 +1,115 @@
+// Command sourcetool inspects Sourcetool apps.
+//
+// Usage:
+//
+//	sourcetool manifest [-diff snapshot.json] [package]
+//
+// The manifest command builds and runs the app in package (default ".") with
+// the -manifest flag and reads the manifest the app writes to stdout. Without
+// -diff it prints the manifest as JSON. With -diff it compares the manifest
+// against a committed snapshot and exits with status 1 when they differ.
+//
+// Apps opt in by writing the manifest instead of calling Listen when the
+// flag is set:
+//
+//	manifest := flag.Bool("manifest", false, "write the page manifest and exit")
+//	flag.Parse()
+//	// register pages ...
+//	if *manifest {
+//		if err := st.WriteManifest(os.Stdout); err != nil {
+//			log.Fatal(err)
+//		}
+//		return
+//	}
+//	if err := st.Listen(); err != nil {
+//		log.Fatal(err)
+//	}
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+
+	"github.com/trysourcetool/sourcetool-go"
+)
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] != "manifest" {
+		fmt.Fprintln(os.Stderr, "usage: sourcetool manifest [-diff snapshot.json] [package]")
+		os.Exit(2)
+	}
+
+	changed, err := runManifest(os.Args[2:], os.Stdout)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "sourcetool: %v\n", err)
+		os.Exit(2)
+	}
+	if changed {
+		os.Exit(1)
+	}
+}
+
+// runManifest runs the manifest command and reports whether the manifest
+// differs from the snapshot given with -diff.
+func runManifest(args []string, w io.Writer) (bool, error) {
+	fs := flag.NewFlagSet("manifest", flag.ContinueOnError)
+	snapshot := fs.String("diff", "", "compare the manifest against this JSON snapshot")
+	if err := fs.Parse(args); err != nil {
+		return false, err
+	}
+	pkg := "."
+	if fs.NArg() > 0 {
+		pkg = fs.Arg(0)
+	}
+
+	data, err := loadManifest(pkg)
+	if err != nil {
+		return false, err
+	}
+
+	if *snapshot == "" {
+		_, err := w.Write(data)
+		return false, err
+	}
+
+	var got, want []sourcetool.PageManifest
+	if err := json.Unmarshal(data, &got); err != nil {
+		return false, fmt.Errorf("failed to decode manifest: %v", err)
+	}
+	snapshotData, err := os.ReadFile(*snapshot)
+	if err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(snapshotData, &want); err != nil {
+		return false, fmt.Errorf("failed to decode snapshot %s: %v", *snapshot, err)
+	}
+
+	lines := diffManifests(want, got)
+	for _, line := range lines {
+		fmt.Fprintln(w, line)
+	}
+	return len(lines) > 0, nil
+}
+
+// loadManifest runs the app in pkg with the -manifest flag and returns the
+// manifest it writes to stdout.
+func loadManifest(pkg string) ([]byte, error) {
+	var stdout bytes.Buffer
+	cmd := exec.Command("go", "run", pkg, "-manifest")
+	cmd.Stdout = &stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("failed to run %s: %v", pkg, err)
+	}
+
+	data := stdout.Bytes()
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("%s did not write a manifest; does it call WriteManifest when run with -manifest?", pkg)
+	}
+	return data, nil
+}
@@ -0,0 +1,100 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/code"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) Code(src, language string, opts ...code.Option) {
+	codeOpts := &options.CodeOptions{
+		Code:           src,
+		Language:       language,
+		LineNumbers:    false,
+		HighlightLines: nil,
+		Wrap:           false,
+	}
+
+	for _, o := range opts {
+		o.Apply(codeOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return
+	}
+	path := cursor.getPath()
+
+	highlightLines := make([]int32, len(codeOpts.HighlightLines))
+	for i, l := range codeOpts.HighlightLines {
+		highlightLines[i] = int32(l)
+	}
+
+	widgetID := b.generatePageID(state.WidgetTypeCode, path)
+	codeState := sess.State.GetCode(widgetID)
+	if codeState == nil {
+		codeState = &state.CodeState{
+			ID: widgetID,
+		}
+	}
+	codeState.Code = codeOpts.Code
+	codeState.Language = codeOpts.Language
+	codeState.LineNumbers = codeOpts.LineNumbers
+	codeState.HighlightLines = highlightLines
+	codeState.Wrap = codeOpts.Wrap
+	sess.State.Set(widgetID, codeState)
+
+	codeProto := convertStateToCodeProto(codeState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Code{
+				Code: codeProto,
+			},
+		},
+	})
+
+	cursor.next()
+}
+
+func convertStateToCodeProto(state *state.CodeState) *widgetv1.Code {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Code{
+		Code:           state.Code,
+		Language:       state.Language,
+		LineNumbers:    state.LineNumbers,
+		HighlightLines: state.HighlightLines,
+		Wrap:           state.Wrap,
+	}
+}
+
+func convertCodeProtoToState(id uuid.UUID, data *widgetv1.Code) *state.CodeState {
+	if data == nil {
+		return nil
+	}
+	return &state.CodeState{
+		ID:             id,
+		Code:           data.Code,
+		Language:       data.Language,
+		LineNumbers:    data.LineNumbers,
+		HighlightLines: data.HighlightLines,
+		Wrap:           data.Wrap,
+	}
+}
@@ -0,0 +1,37 @@
+package code
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.CodeOptions)
+}
+
+type lineNumbersOption bool
+
+func (l lineNumbersOption) Apply(opts *options.CodeOptions) {
+	opts.LineNumbers = bool(l)
+}
+
+func WithLineNumbers(lineNumbers bool) Option {
+	return lineNumbersOption(lineNumbers)
+}
+
+type highlightLinesOption []int
+
+func (h highlightLinesOption) Apply(opts *options.CodeOptions) {
+	opts.HighlightLines = []int(h)
+}
+
+func WithHighlightLines(lines ...int) Option {
+	return highlightLinesOption(lines)
+}
+
+type wrapOption bool
+
+func (w wrapOption) Apply(opts *options.CodeOptions) {
+	opts.Wrap = bool(w)
+}
+
+func WithWrap(wrap bool) Option {
+	return wrapOption(wrap)
+}
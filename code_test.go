@@ -0,0 +1,147 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/code"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToCodeProto(t *testing.T) {
+	codeState := &state.CodeState{
+		ID:             uuid.Must(uuid.NewV4()),
+		Code:           "fmt.Println(\"```\")",
+		Language:       "go",
+		LineNumbers:    true,
+		HighlightLines: []int32{1},
+		Wrap:           true,
+	}
+
+	data := convertStateToCodeProto(codeState)
+
+	if data == nil {
+		t.Fatal("convertStateToCodeProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Code", data.Code, codeState.Code},
+		{"Language", data.Language, codeState.Language},
+		{"LineNumbers", data.LineNumbers, codeState.LineNumbers},
+		{"HighlightLines", data.HighlightLines[0], codeState.HighlightLines[0]},
+		{"Wrap", data.Wrap, codeState.Wrap},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertCodeProtoToState(t *testing.T) {
+	data := &widgetv1.Code{
+		Code:           "SELECT 1;",
+		Language:       "sql",
+		LineNumbers:    true,
+		HighlightLines: []int32{1},
+	}
+
+	state := convertCodeProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertCodeProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Code", state.Code, data.Code},
+		{"Language", state.Language, data.Language},
+		{"LineNumbers", state.LineNumbers, data.LineNumbers},
+		{"HighlightLines", state.HighlightLines[0], data.HighlightLines[0]},
+		{"Wrap", state.Wrap, data.Wrap},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCode(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	src := "{\n  \"body\": \"```\"\n}"
+	builder.Code(src, "json",
+		code.WithLineNumbers(true),
+		code.WithHighlightLines(2, 3),
+	)
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	if v := messages[0].GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeCode, []int{0})
+	state := sess.State.GetCode(widgetID)
+	if state == nil {
+		t.Fatal("Code state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Code", state.Code, src},
+		{"Language", state.Language, "json"},
+		{"LineNumbers", state.LineNumbers, true},
+		{"HighlightLines length", len(state.HighlightLines), 2},
+		{"HighlightLines[1]", state.HighlightLines[1], int32(3)},
+		{"Wrap", state.Wrap, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package sourcetool
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/colorpicker"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+const defaultColorPickerValue = "#000000"
+
+func (b *uiBuilder) ColorPicker(label string, opts ...colorpicker.Option) string {
+	colorPickerOpts := &options.ColorPickerOptions{
+		Label:        label,
+		DefaultValue: defaultColorPickerValue,
+		Required:     false,
+		Disabled:     false,
+	}
+
+	for _, o := range opts {
+		o.Apply(colorPickerOpts)
+	}
+
+	defaultValue, err := normalizeHexColor(colorPickerOpts.DefaultValue)
+	if err != nil {
+		defaultValue = defaultColorPickerValue
+	}
+
+	sess := b.session
+	if sess == nil {
+		return defaultValue
+	}
+	page := b.page
+	if page == nil {
+		return defaultValue
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return defaultValue
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeColorPicker, path)
+	colorPickerState := sess.State.GetColorPicker(widgetID)
+	if colorPickerState == nil {
+		colorPickerState = &state.ColorPickerState{
+			ID:    widgetID,
+			Value: defaultValue,
+		}
+	}
+	colorPickerState.Label = colorPickerOpts.Label
+	colorPickerState.DefaultValue = defaultValue
+	colorPickerState.Required = colorPickerOpts.Required
+	colorPickerState.Disabled = colorPickerOpts.Disabled
+	sess.State.Set(widgetID, colorPickerState)
+
+	colorPickerProto := convertStateToColorPickerProto(colorPickerState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_ColorPicker{
+				ColorPicker: colorPickerProto,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return colorPickerState.Value
+}
+
+// normalizeHexColor converts "#rgb" and "#rrggbb" colors to lowercase "#rrggbb".
+func normalizeHexColor(s string) (string, error) {
+	hex := strings.ToLower(strings.TrimPrefix(s, "#"))
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return "", fmt.Errorf("invalid hex color %q", s)
+	}
+	for _, c := range hex {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return "", fmt.Errorf("invalid hex color %q", s)
+		}
+	}
+	return "#" + hex, nil
+}
+
+func convertStateToColorPickerProto(state *state.ColorPickerState) *widgetv1.ColorPicker {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.ColorPicker{
+		Value:        state.Value,
+		Label:        state.Label,
+		DefaultValue: state.DefaultValue,
+		Required:     state.Required,
+		Disabled:     state.Disabled,
+	}
+}
+
+// convertColorPickerProtoToState treats an empty value as unset and returns
+// nil, so the picker falls back to its default on the next run.
+func convertColorPickerProtoToState(id uuid.UUID, data *widgetv1.ColorPicker) (*state.ColorPickerState, error) {
+	if data == nil || data.Value == "" {
+		return nil, nil
+	}
+	value, err := normalizeHexColor(data.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &state.ColorPickerState{
+		ID:           id,
+		Value:        value,
+		Label:        data.Label,
+		DefaultValue: data.DefaultValue,
+		Required:     data.Required,
+		Disabled:     data.Disabled,
+	}, nil
+}
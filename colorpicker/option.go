@@ -0,0 +1,38 @@
+package colorpicker
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.ColorPickerOptions)
+}
+
+type defaultValueOption string
+
+func (d defaultValueOption) Apply(opts *options.ColorPickerOptions) {
+	opts.DefaultValue = string(d)
+}
+
+// WithDefaultValue sets the initial color as a hex string such as "#1e90ff" or "#fff".
+func WithDefaultValue(defaultValue string) Option {
+	return defaultValueOption(defaultValue)
+}
+
+type requiredOption bool
+
+func (r requiredOption) Apply(opts *options.ColorPickerOptions) {
+	opts.Required = bool(r)
+}
+
+func WithRequired(required bool) Option {
+	return requiredOption(required)
+}
+
+type disabledOption bool
+
+func (d disabledOption) Apply(opts *options.ColorPickerOptions) {
+	opts.Disabled = bool(d)
+}
+
+func WithDisabled(disabled bool) Option {
+	return disabledOption(disabled)
+}
@@ -0,0 +1,236 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/colorpicker"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToColorPickerProto(t *testing.T) {
+	colorPickerState := &state.ColorPickerState{
+		ID:           uuid.Must(uuid.NewV4()),
+		Label:        "Test ColorPicker",
+		Value:        "#1e90ff",
+		DefaultValue: "#000000",
+		Required:     true,
+		Disabled:     false,
+	}
+
+	data := convertStateToColorPickerProto(colorPickerState)
+
+	if data == nil {
+		t.Fatal("convertStateToColorPickerProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", data.Label, colorPickerState.Label},
+		{"Value", data.Value, colorPickerState.Value},
+		{"DefaultValue", data.DefaultValue, colorPickerState.DefaultValue},
+		{"Required", data.Required, colorPickerState.Required},
+		{"Disabled", data.Disabled, colorPickerState.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertColorPickerProtoToState(t *testing.T) {
+	data := &widgetv1.ColorPicker{
+		Label:        "Test ColorPicker",
+		Value:        "#ABC",
+		DefaultValue: "#000000",
+		Required:     false,
+		Disabled:     true,
+	}
+
+	state, err := convertColorPickerProtoToState(uuid.Must(uuid.NewV4()), data)
+	if err != nil {
+		t.Fatalf("convertColorPickerProtoToState returned error: %v", err)
+	}
+	if state == nil {
+		t.Fatal("convertColorPickerProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, data.Label},
+		{"Value", state.Value, "#aabbcc"},
+		{"DefaultValue", state.DefaultValue, data.DefaultValue},
+		{"Required", state.Required, data.Required},
+		{"Disabled", state.Disabled, data.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	data.Value = "blue"
+	if _, err := convertColorPickerProtoToState(uuid.Must(uuid.NewV4()), data); err == nil {
+		t.Error("convertColorPickerProtoToState with invalid color returned nil error")
+	}
+
+	data.Value = ""
+	state, err = convertColorPickerProtoToState(uuid.Must(uuid.NewV4()), data)
+	if err != nil {
+		t.Errorf("convertColorPickerProtoToState with empty value returned error: %v", err)
+	}
+	if state != nil {
+		t.Errorf("convertColorPickerProtoToState with empty value = %+v, want nil", state)
+	}
+}
+
+func TestNormalizeHexColor(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{"#1E90FF", "#1e90ff", false},
+		{"1e90ff", "#1e90ff", false},
+		{"#fff", "#ffffff", false},
+		{"#ggg", "", true},
+		{"#12345", "", true},
+		{"", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := normalizeHexColor(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeHexColor(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("normalizeHexColor(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColorPicker(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	label := "Brand color"
+	value := builder.ColorPicker(label,
+		colorpicker.WithDefaultValue("#F80"),
+		colorpicker.WithRequired(true),
+	)
+
+	if value != "#ff8800" {
+		t.Errorf("ColorPicker value = %q, want %q", value, "#ff8800")
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeColorPicker, []int{0})
+	state := sess.State.GetColorPicker(widgetID)
+	if state == nil {
+		t.Fatal("ColorPicker state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, label},
+		{"Value", state.Value, "#ff8800"},
+		{"DefaultValue", state.DefaultValue, "#ff8800"},
+		{"Required", state.Required, true},
+		{"Disabled", state.Disabled, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuntime_ColorPickerEmptyValue(t *testing.T) {
+	pageID := uuid.Must(uuid.NewV4())
+	sessionID := uuid.Must(uuid.NewV4())
+
+	var got string
+	pages := map[uuid.UUID]*page{
+		pageID: {
+			id: pageID,
+			handler: func(ui UIBuilder) error {
+				got = ui.ColorPicker("Brand color", colorpicker.WithDefaultValue("#ff8800"))
+				return nil
+			},
+		},
+	}
+
+	r := &runtime{
+		wsClient:       mock.NewClient(),
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(pages),
+	}
+	r.sessionManager.SetSession(session.New(sessionID, pageID))
+
+	ui := &uiBuilder{page: pages[pageID]}
+	widgetID := ui.generatePageID(state.WidgetTypeColorPicker, []int{0})
+
+	if err := r.handleRerunPage(&websocketv1.RerunPage{
+		SessionId: sessionID.String(),
+		PageId:    pageID.String(),
+		States: []*widgetv1.Widget{
+			{
+				Id: widgetID.String(),
+				Type: &widgetv1.Widget_ColorPicker{
+					ColorPicker: &widgetv1.ColorPicker{Label: "Brand color"},
+				},
+			},
+		},
+	}); err != nil {
+		t.Fatalf("handleRerunPage returned error: %v", err)
+	}
+	if got != "#ff8800" {
+		t.Errorf("ColorPicker value = %q, want %q", got, "#ff8800")
+	}
+}
@@ -1,6 +1,86 @@
 package sourcetool
 
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
 type Config struct {
 	APIKey   string
 	Endpoint string
+	// Namespace seeds page IDs. It defaults to the endpoint hostname; set it
+	// to keep page IDs stable when the endpoint changes.
+	Namespace string
+}
+
+const (
+	envAPIKey         = "SOURCETOOL_API_KEY"
+	envEndpoint       = "SOURCETOOL_ENDPOINT"
+	envNamespace      = "SOURCETOOL_NAMESPACE"
+	envPingInterval   = "SOURCETOOL_PING_INTERVAL"
+	envReconnectDelay = "SOURCETOOL_RECONNECT_DELAY"
+	envQueueSize      = "SOURCETOOL_QUEUE_SIZE"
+	envTimezone       = "SOURCETOOL_TIMEZONE"
+)
+
+// NewFromEnv creates a Sourcetool configured from environment variables:
+// SOURCETOOL_API_KEY and SOURCETOOL_ENDPOINT are required, and
+// SOURCETOOL_NAMESPACE, SOURCETOOL_PING_INTERVAL and
+// SOURCETOOL_RECONNECT_DELAY (durations such as "5s"), SOURCETOOL_QUEUE_SIZE
+// and SOURCETOOL_TIMEZONE (an IANA name such as "Asia/Tokyo") are optional.
+// Options passed to NewFromEnv take precedence over the environment.
+func NewFromEnv(opts ...Option) (*Sourcetool, error) {
+	config := &Config{
+		APIKey:    os.Getenv(envAPIKey),
+		Endpoint:  os.Getenv(envEndpoint),
+		Namespace: os.Getenv(envNamespace),
+	}
+	if config.APIKey == "" {
+		return nil, fmt.Errorf("%s is not set", envAPIKey)
+	}
+	if config.Endpoint == "" {
+		return nil, fmt.Errorf("%s is not set", envEndpoint)
+	}
+
+	envOpts, err := optionsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	return New(config, append(envOpts, opts...)...)
+}
+
+func optionsFromEnv() ([]Option, error) {
+	var opts []Option
+	if v := os.Getenv(envPingInterval); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", envPingInterval, err)
+		}
+		opts = append(opts, WithPingInterval(d))
+	}
+	if v := os.Getenv(envReconnectDelay); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", envReconnectDelay, err)
+		}
+		opts = append(opts, WithReconnectDelay(d))
+	}
+	if v := os.Getenv(envQueueSize); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", envQueueSize, err)
+		}
+		opts = append(opts, WithQueueSize(n))
+	}
+	if v := os.Getenv(envTimezone); v != "" {
+		loc, err := time.LoadLocation(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", envTimezone, err)
+		}
+		opts = append(opts, WithLocation(*loc))
+	}
+	return opts, nil
 }
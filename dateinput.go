@@ -2,6 +2,7 @@ package sourcetool
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
@@ -24,7 +25,7 @@ func (b *uiBuilder) DateInput(label string, opts ...dateinput.Option) *time.Time
 		Format:       "YYYY/MM/DD",
 		MaxValue:     nil,
 		MinValue:     nil,
-		Location:     time.Local,
+		Location:     b.location(),
 	}
 
 	for _, o := range opts {
@@ -52,6 +53,11 @@ func (b *uiBuilder) DateInput(label string, opts ...dateinput.Option) *time.Time
 			ID:    widgetID,
 			Value: dateInputOpts.DefaultValue,
 		}
+		if v, ok := b.lookupQueryParam(dateInputOpts.QueryParam); ok {
+			if t, err := time.ParseInLocation(time.DateOnly, v[0], dateInputOpts.Location); err == nil {
+				dateInputState.Value = &t
+			}
+		}
 	}
 	dateInputState.Label = dateInputOpts.Label
 	dateInputState.Placeholder = dateInputOpts.Placeholder
@@ -64,6 +70,9 @@ func (b *uiBuilder) DateInput(label string, opts ...dateinput.Option) *time.Time
 	dateInputState.Location = dateInputOpts.Location
 	sess.State.Set(widgetID, dateInputState)
 
+	values := formatTimeParam(dateInputState.Value, time.DateOnly, dateInputOpts.Location)
+	b.bindQueryParam(dateInputOpts.QueryParam, values, slices.Equal(values, formatTimeParam(dateInputOpts.DefaultValue, time.DateOnly, dateInputOpts.Location)))
+
 	dateInput := convertStateToDateInputProto(dateInputState)
 	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
 		SessionId: sess.ID.String(),
@@ -89,3 +89,15 @@ func (l locationOption) Apply(opts *options.DateInputOptions) {
 func WithLocation(location time.Location) Option {
 	return locationOption(location)
 }
+
+type queryParamOption string
+
+func (q queryParamOption) Apply(opts *options.DateInputOptions) {
+	opts.QueryParam = string(q)
+}
+
+// WithQueryParam binds the value to the key query parameter of the page URL.
+// The parameter sets the initial value and is updated when the value changes.
+func WithQueryParam(key string) Option {
+	return queryParamOption(key)
+}
@@ -270,3 +270,34 @@ func TestDateInput_DefaultValues(t *testing.T) {
 		t.Error("Default Disabled = true, want false")
 	}
 }
+
+func TestDateInput_RuntimeLocation(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	location := time.FixedZone("UTC+9", 9*60*60)
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+			location: location,
+		},
+	}
+
+	builder.DateInput("Test DateInput")
+
+	widgetID := builder.generatePageID(state.WidgetTypeDateInput, []int{0})
+	state := sess.State.GetDateInput(widgetID)
+	if state == nil {
+		t.Fatal("DateInput state not found")
+	}
+	if state.Location != location {
+		t.Errorf("Default Location = %v, want %v", state.Location, location)
+	}
+}
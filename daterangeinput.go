@@ -0,0 +1,224 @@
+package sourcetool
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/daterangeinput"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/ptrconv"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) DateRangeInput(label string, opts ...daterangeinput.Option) (*time.Time, *time.Time) {
+	dateRangeInputOpts := &options.DateRangeInputOptions{
+		DateInputOptions: options.DateInputOptions{
+			Label:       label,
+			Placeholder: "",
+			Required:    false,
+			Disabled:    false,
+			Format:      "YYYY/MM/DD",
+			MaxValue:    nil,
+			MinValue:    nil,
+			Location:    b.location(),
+		},
+		DefaultFromValue: nil,
+		DefaultToValue:   nil,
+		Presets:          nil,
+	}
+
+	for _, o := range opts {
+		o.Apply(dateRangeInputOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return nil, nil
+	}
+	page := b.page
+	if page == nil {
+		return nil, nil
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return nil, nil
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeDateRangeInput, path)
+	dateRangeInputState := sess.State.GetDateRangeInput(widgetID)
+	if dateRangeInputState == nil {
+		dateRangeInputState = &state.DateRangeInputState{
+			ID:        widgetID,
+			FromValue: dateRangeInputOpts.DefaultFromValue,
+			ToValue:   dateRangeInputOpts.DefaultToValue,
+		}
+	}
+	from, to := dateRangeInputState.FromValue, dateRangeInputState.ToValue
+	if from != nil && to != nil && from.After(*to) {
+		dateRangeInputState.FromValue, dateRangeInputState.ToValue = to, from
+	}
+
+	today := truncateToDate(time.Now(), dateRangeInputOpts.Location)
+	presets := make([]state.DateRangeInputStatePreset, 0, len(dateRangeInputOpts.Presets))
+	for _, p := range dateRangeInputOpts.Presets {
+		from, to := p.Range(today)
+		presets = append(presets, state.DateRangeInputStatePreset{
+			Label: p.Label,
+			From:  from,
+			To:    to,
+		})
+	}
+
+	dateRangeInputState.Label = dateRangeInputOpts.Label
+	dateRangeInputState.Placeholder = dateRangeInputOpts.Placeholder
+	dateRangeInputState.DefaultFromValue = dateRangeInputOpts.DefaultFromValue
+	dateRangeInputState.DefaultToValue = dateRangeInputOpts.DefaultToValue
+	dateRangeInputState.Required = dateRangeInputOpts.Required
+	dateRangeInputState.Disabled = dateRangeInputOpts.Disabled
+	dateRangeInputState.Format = dateRangeInputOpts.Format
+	dateRangeInputState.MaxValue = dateRangeInputOpts.MaxValue
+	dateRangeInputState.MinValue = dateRangeInputOpts.MinValue
+	dateRangeInputState.Location = dateRangeInputOpts.Location
+	dateRangeInputState.Presets = presets
+	sess.State.Set(widgetID, dateRangeInputState)
+
+	dateRangeInput := convertStateToDateRangeInputProto(dateRangeInputState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_DateRangeInput{
+				DateRangeInput: dateRangeInput,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return dateRangeInputState.FromValue, dateRangeInputState.ToValue
+}
+
+func convertDateRangeInputProtoToState(id uuid.UUID, data *widgetv1.DateRangeInput, location *time.Location) (*state.DateRangeInputState, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	parseDate := func(dateStr string) (*time.Time, error) {
+		if dateStr == "" {
+			return nil, nil
+		}
+		t, err := time.ParseInLocation(time.DateOnly, dateStr, location)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse date %q: %v", dateStr, err)
+		}
+		return &t, nil
+	}
+
+	fromValue, err := parseDate(ptrconv.StringValue(data.FromValue))
+	if err != nil {
+		return nil, err
+	}
+
+	toValue, err := parseDate(ptrconv.StringValue(data.ToValue))
+	if err != nil {
+		return nil, err
+	}
+
+	defaultFromValue, err := parseDate(ptrconv.StringValue(data.DefaultFromValue))
+	if err != nil {
+		return nil, err
+	}
+
+	defaultToValue, err := parseDate(ptrconv.StringValue(data.DefaultToValue))
+	if err != nil {
+		return nil, err
+	}
+
+	maxValue, err := parseDate(data.MaxValue)
+	if err != nil {
+		return nil, err
+	}
+
+	minValue, err := parseDate(data.MinValue)
+	if err != nil {
+		return nil, err
+	}
+
+	presets := make([]state.DateRangeInputStatePreset, 0, len(data.Presets))
+	for _, p := range data.Presets {
+		from, err := parseDate(p.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := parseDate(p.To)
+		if err != nil {
+			return nil, err
+		}
+		if from == nil || to == nil {
+			return nil, fmt.Errorf("preset %q has an empty range", p.Label)
+		}
+		presets = append(presets, state.DateRangeInputStatePreset{
+			Label: p.Label,
+			From:  *from,
+			To:    *to,
+		})
+	}
+
+	return &state.DateRangeInputState{
+		ID:               id,
+		FromValue:        fromValue,
+		ToValue:          toValue,
+		Label:            data.Label,
+		Placeholder:      data.Placeholder,
+		DefaultFromValue: defaultFromValue,
+		DefaultToValue:   defaultToValue,
+		Required:         data.Required,
+		Disabled:         data.Disabled,
+		Format:           data.Format,
+		MaxValue:         maxValue,
+		MinValue:         minValue,
+		Location:         location,
+		Presets:          presets,
+	}, nil
+}
+
+func convertStateToDateRangeInputProto(state *state.DateRangeInputState) *widgetv1.DateRangeInput {
+	if state == nil {
+		return nil
+	}
+	formatDate := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format(time.DateOnly)
+	}
+	presets := make([]*widgetv1.DateRangeInputPreset, 0, len(state.Presets))
+	for _, p := range state.Presets {
+		presets = append(presets, &widgetv1.DateRangeInputPreset{
+			Label: p.Label,
+			From:  p.From.Format(time.DateOnly),
+			To:    p.To.Format(time.DateOnly),
+		})
+	}
+	return &widgetv1.DateRangeInput{
+		FromValue:        ptrconv.StringPtr(formatDate(state.FromValue)),
+		ToValue:          ptrconv.StringPtr(formatDate(state.ToValue)),
+		Label:            state.Label,
+		Placeholder:      state.Placeholder,
+		DefaultFromValue: ptrconv.StringPtr(formatDate(state.DefaultFromValue)),
+		DefaultToValue:   ptrconv.StringPtr(formatDate(state.DefaultToValue)),
+		Required:         state.Required,
+		Disabled:         state.Disabled,
+		Format:           state.Format,
+		MaxValue:         formatDate(state.MaxValue),
+		MinValue:         formatDate(state.MinValue),
+		Presets:          presets,
+	}
+}
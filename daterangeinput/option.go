@@ -0,0 +1,80 @@
+package daterangeinput
+
+import (
+	"time"
+
+	"github.com/trysourcetool/sourcetool-go/dateinput"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+)
+
+type Option interface {
+	Apply(*options.DateRangeInputOptions)
+}
+
+// dateInputOption adapts a dateinput option so that both ends of the range share it.
+type dateInputOption struct {
+	dateinput.Option
+}
+
+func (d dateInputOption) Apply(opts *options.DateRangeInputOptions) {
+	d.Option.Apply(&opts.DateInputOptions)
+}
+
+func WithPlaceholder(placeholder string) Option {
+	return dateInputOption{dateinput.WithPlaceholder(placeholder)}
+}
+
+func WithRequired(required bool) Option {
+	return dateInputOption{dateinput.WithRequired(required)}
+}
+
+func WithDisabled(disabled bool) Option {
+	return dateInputOption{dateinput.WithDisabled(disabled)}
+}
+
+func WithFormat(format string) Option {
+	return dateInputOption{dateinput.WithFormat(format)}
+}
+
+func WithMaxValue(value time.Time) Option {
+	return dateInputOption{dateinput.WithMaxValue(value)}
+}
+
+func WithMinValue(value time.Time) Option {
+	return dateInputOption{dateinput.WithMinValue(value)}
+}
+
+func WithLocation(location time.Location) Option {
+	return dateInputOption{dateinput.WithLocation(location)}
+}
+
+type defaultValueOption [2]time.Time
+
+func (d defaultValueOption) Apply(opts *options.DateRangeInputOptions) {
+	opts.DefaultFromValue = &d[0]
+	opts.DefaultToValue = &d[1]
+}
+
+func WithDefaultValue(from, to time.Time) Option {
+	return defaultValueOption{from, to}
+}
+
+type presetsOption []Preset
+
+func (p presetsOption) Apply(opts *options.DateRangeInputOptions) {
+	for _, preset := range p {
+		if preset.Range == nil {
+			continue
+		}
+		opts.Presets = append(opts.Presets, options.DateRangeInputPreset{
+			Label: preset.Label,
+			Range: preset.Range,
+		})
+	}
+}
+
+// WithPresets adds quick-pick ranges shown next to the input. Presets
+// without a Range function are ignored.
+func WithPresets(presets ...Preset) Option {
+	return presetsOption(presets)
+}
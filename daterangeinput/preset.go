@@ -0,0 +1,50 @@
+package daterangeinput
+
+import "time"
+
+// Preset is a named range offered next to the input.
+// Range receives midnight of the current day in the input's location.
+type Preset struct {
+	Label string
+	Range func(today time.Time) (from, to time.Time)
+}
+
+var (
+	PresetToday = Preset{
+		Label: "Today",
+		Range: func(today time.Time) (time.Time, time.Time) {
+			return today, today
+		},
+	}
+	PresetLast7Days = Preset{
+		Label: "Last 7 days",
+		Range: func(today time.Time) (time.Time, time.Time) {
+			return today.AddDate(0, 0, -6), today
+		},
+	}
+	PresetLast30Days = Preset{
+		Label: "Last 30 days",
+		Range: func(today time.Time) (time.Time, time.Time) {
+			return today.AddDate(0, 0, -29), today
+		},
+	}
+	PresetThisMonth = Preset{
+		Label: "This month",
+		Range: func(today time.Time) (time.Time, time.Time) {
+			return today.AddDate(0, 0, 1-today.Day()), today
+		},
+	}
+	PresetLastMonth = Preset{
+		Label: "Last month",
+		Range: func(today time.Time) (time.Time, time.Time) {
+			firstOfMonth := today.AddDate(0, 0, 1-today.Day())
+			return firstOfMonth.AddDate(0, -1, 0), firstOfMonth.AddDate(0, 0, -1)
+		},
+	}
+	PresetThisYear = Preset{
+		Label: "This year",
+		Range: func(today time.Time) (time.Time, time.Time) {
+			return today.AddDate(0, 0, 1-today.YearDay()), today
+		},
+	}
+)
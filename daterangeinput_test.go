@@ -0,0 +1,272 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/daterangeinput"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToDateRangeInputProto(t *testing.T) {
+	date := func(m time.Month, d int) *time.Time {
+		v := time.Date(2025, m, d, 0, 0, 0, 0, time.UTC)
+		return &v
+	}
+	dateRangeInputState := &state.DateRangeInputState{
+		ID:          uuid.Must(uuid.NewV4()),
+		FromValue:   date(1, 5),
+		ToValue:     date(1, 10),
+		Label:       "Test DateRangeInput",
+		Placeholder: "Select period",
+		Required:    true,
+		Format:      "YYYY/MM/DD",
+		MaxValue:    date(12, 31),
+		MinValue:    date(1, 1),
+		Location:    time.UTC,
+		Presets: []state.DateRangeInputStatePreset{
+			{Label: "January", From: *date(1, 1), To: *date(1, 31)},
+		},
+	}
+
+	data := convertStateToDateRangeInputProto(dateRangeInputState)
+
+	if data == nil {
+		t.Fatal("convertStateToDateRangeInputProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"FromValue", *data.FromValue, "2025-01-05"},
+		{"ToValue", *data.ToValue, "2025-01-10"},
+		{"Label", data.Label, dateRangeInputState.Label},
+		{"Placeholder", data.Placeholder, dateRangeInputState.Placeholder},
+		{"DefaultFromValue", data.DefaultFromValue == nil, true},
+		{"DefaultToValue", data.DefaultToValue == nil, true},
+		{"Required", data.Required, dateRangeInputState.Required},
+		{"Disabled", data.Disabled, dateRangeInputState.Disabled},
+		{"Format", data.Format, dateRangeInputState.Format},
+		{"MaxValue", data.MaxValue, "2025-12-31"},
+		{"MinValue", data.MinValue, "2025-01-01"},
+		{"Presets length", len(data.Presets), 1},
+		{"Preset label", data.Presets[0].Label, "January"},
+		{"Preset from", data.Presets[0].From, "2025-01-01"},
+		{"Preset to", data.Presets[0].To, "2025-01-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertDateRangeInputProtoToState(t *testing.T) {
+	from := "2025-01-05"
+	to := "2025-01-10"
+	data := &widgetv1.DateRangeInput{
+		FromValue: &from,
+		ToValue:   &to,
+		Label:     "Test DateRangeInput",
+		Format:    "YYYY/MM/DD",
+		MinValue:  "2025-01-01",
+		Presets: []*widgetv1.DateRangeInputPreset{
+			{Label: "January", From: "2025-01-01", To: "2025-01-31"},
+		},
+	}
+
+	state, err := convertDateRangeInputProtoToState(uuid.Must(uuid.NewV4()), data, time.UTC)
+	if err != nil {
+		t.Fatalf("convertDateRangeInputProtoToState returned error: %v", err)
+	}
+	if state == nil {
+		t.Fatal("convertDateRangeInputProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"FromValue", state.FromValue.Format(time.DateOnly), from},
+		{"ToValue", state.ToValue.Format(time.DateOnly), to},
+		{"Label", state.Label, data.Label},
+		{"DefaultFromValue", state.DefaultFromValue == nil, true},
+		{"Format", state.Format, data.Format},
+		{"MaxValue", state.MaxValue == nil, true},
+		{"MinValue", state.MinValue.Format(time.DateOnly), data.MinValue},
+		{"Location", state.Location, time.UTC},
+		{"Presets length", len(state.Presets), 1},
+		{"Preset to", state.Presets[0].To.Format(time.DateOnly), "2025-01-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	data.Presets[0].To = ""
+	if _, err := convertDateRangeInputProtoToState(uuid.Must(uuid.NewV4()), data, time.UTC); err == nil {
+		t.Error("convertDateRangeInputProtoToState with empty preset returned nil error")
+	}
+}
+
+func TestDateRangeInput(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	defaultFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	defaultTo := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	from, to := builder.DateRangeInput("Period",
+		daterangeinput.WithDefaultValue(defaultFrom, defaultTo),
+		daterangeinput.WithFormat("DD/MM/YYYY"),
+		daterangeinput.WithRequired(true),
+		daterangeinput.WithLocation(*time.UTC),
+		daterangeinput.WithPresets(daterangeinput.PresetLast7Days, daterangeinput.PresetThisMonth),
+	)
+
+	if from == nil || !from.Equal(defaultFrom) {
+		t.Errorf("DateRangeInput from = %v, want %v", from, defaultFrom)
+	}
+	if to == nil || !to.Equal(defaultTo) {
+		t.Errorf("DateRangeInput to = %v, want %v", to, defaultTo)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeDateRangeInput, []int{0})
+	state := sess.State.GetDateRangeInput(widgetID)
+	if state == nil {
+		t.Fatal("DateRangeInput state not found")
+	}
+
+	today := truncateToDate(time.Now(), time.UTC)
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Format", state.Format, "DD/MM/YYYY"},
+		{"Required", state.Required, true},
+		{"Location", state.Location.String(), "UTC"},
+		{"Presets length", len(state.Presets), 2},
+		{"Last 7 days label", state.Presets[0].Label, "Last 7 days"},
+		{"Last 7 days from", state.Presets[0].From.Format(time.DateOnly), today.AddDate(0, 0, -6).Format(time.DateOnly)},
+		{"Last 7 days to", state.Presets[0].To.Format(time.DateOnly), today.Format(time.DateOnly)},
+		{"This month from day", state.Presets[1].From.Day(), 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	// A reversed range sent by the client is normalized on the next run.
+	state.FromValue, state.ToValue = &defaultTo, &defaultFrom
+	builder.cursor = newCursor()
+	from, to = builder.DateRangeInput("Period", daterangeinput.WithLocation(*time.UTC))
+	if !from.Equal(defaultFrom) || !to.Equal(defaultTo) {
+		t.Errorf("DateRangeInput reversed range = (%v, %v), want (%v, %v)", from, to, defaultFrom, defaultTo)
+	}
+}
+
+func TestDateRangeInputPresets(t *testing.T) {
+	today := time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		preset   daterangeinput.Preset
+		wantFrom string
+		wantTo   string
+	}{
+		{daterangeinput.PresetToday, "2025-03-15", "2025-03-15"},
+		{daterangeinput.PresetLast7Days, "2025-03-09", "2025-03-15"},
+		{daterangeinput.PresetLast30Days, "2025-02-14", "2025-03-15"},
+		{daterangeinput.PresetThisMonth, "2025-03-01", "2025-03-15"},
+		{daterangeinput.PresetLastMonth, "2025-02-01", "2025-02-28"},
+		{daterangeinput.PresetThisYear, "2025-01-01", "2025-03-15"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.preset.Label, func(t *testing.T) {
+			from, to := tt.preset.Range(today)
+			if got := from.Format(time.DateOnly); got != tt.wantFrom {
+				t.Errorf("from = %s, want %s", got, tt.wantFrom)
+			}
+			if got := to.Format(time.DateOnly); got != tt.wantTo {
+				t.Errorf("to = %s, want %s", got, tt.wantTo)
+			}
+		})
+	}
+}
+
+func TestDateRangeInput_PresetWithoutRange(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	builder.DateRangeInput("Period",
+		daterangeinput.WithPresets(
+			daterangeinput.Preset{Label: "Broken"},
+			daterangeinput.PresetToday,
+		),
+	)
+
+	widgetID := builder.generatePageID(state.WidgetTypeDateRangeInput, []int{0})
+	state := sess.State.GetDateRangeInput(widgetID)
+	if state == nil {
+		t.Fatal("DateRangeInput state not found")
+	}
+	if len(state.Presets) != 1 {
+		t.Fatalf("Presets count = %d, want 1", len(state.Presets))
+	}
+	if state.Presets[0].Label != daterangeinput.PresetToday.Label {
+		t.Errorf("Preset label = %q, want %q", state.Presets[0].Label, daterangeinput.PresetToday.Label)
+	}
+}
@@ -0,0 +1,194 @@
+package sourcetool
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/daterangeslider"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+// defaultDateRangeSliderStep is the step in days used when none or a
+// non-positive one is given.
+const defaultDateRangeSliderStep = 1
+
+func (b *uiBuilder) DateRangeSlider(label string, opts ...daterangeslider.Option) [2]time.Time {
+	dateRangeSliderOpts := &options.DateRangeSliderOptions{
+		Label:        label,
+		DefaultValue: nil,
+		MinValue:     nil,
+		MaxValue:     nil,
+		Step:         defaultDateRangeSliderStep,
+		Format:       "YYYY/MM/DD",
+		Disabled:     false,
+		Location:     b.location(),
+	}
+
+	for _, o := range opts {
+		o.Apply(dateRangeSliderOpts)
+	}
+
+	location := dateRangeSliderOpts.Location
+	maxValue := truncateToDate(time.Now(), location)
+	if dateRangeSliderOpts.MaxValue != nil {
+		maxValue = truncateToDate(*dateRangeSliderOpts.MaxValue, location)
+	}
+	// Without an explicit lower bound the slider spans the 30 days up to maxValue.
+	minValue := maxValue.AddDate(0, 0, -30)
+	if dateRangeSliderOpts.MinValue != nil {
+		minValue = truncateToDate(*dateRangeSliderOpts.MinValue, location)
+	}
+	if minValue.After(maxValue) {
+		minValue, maxValue = maxValue, minValue
+	}
+	step := dateRangeSliderOpts.Step
+	if step <= 0 {
+		step = defaultDateRangeSliderStep
+	}
+
+	defaultValue := [2]time.Time{minValue, maxValue}
+	if dateRangeSliderOpts.DefaultValue != nil {
+		defaultValue = normalizeDateRange(dateRangeSliderOpts.DefaultValue[0], dateRangeSliderOpts.DefaultValue[1], minValue, maxValue, location)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return defaultValue
+	}
+	page := b.page
+	if page == nil {
+		return defaultValue
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return defaultValue
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeDateRangeSlider, path)
+	dateRangeSliderState := sess.State.GetDateRangeSlider(widgetID)
+	if dateRangeSliderState == nil {
+		dateRangeSliderState = &state.DateRangeSliderState{
+			ID:        widgetID,
+			LowValue:  defaultValue[0],
+			HighValue: defaultValue[1],
+		}
+	}
+	value := normalizeDateRange(dateRangeSliderState.LowValue, dateRangeSliderState.HighValue, minValue, maxValue, location)
+	dateRangeSliderState.LowValue = value[0]
+	dateRangeSliderState.HighValue = value[1]
+	dateRangeSliderState.Label = dateRangeSliderOpts.Label
+	dateRangeSliderState.DefaultLowValue = defaultValue[0]
+	dateRangeSliderState.DefaultHighValue = defaultValue[1]
+	dateRangeSliderState.MinValue = minValue
+	dateRangeSliderState.MaxValue = maxValue
+	dateRangeSliderState.Step = step
+	dateRangeSliderState.Format = dateRangeSliderOpts.Format
+	dateRangeSliderState.Disabled = dateRangeSliderOpts.Disabled
+	dateRangeSliderState.Location = location
+	sess.State.Set(widgetID, dateRangeSliderState)
+
+	dateRangeSlider := convertStateToDateRangeSliderProto(dateRangeSliderState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_DateRangeSlider{
+				DateRangeSlider: dateRangeSlider,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return value
+}
+
+func truncateToDate(t time.Time, location *time.Location) time.Time {
+	y, m, d := t.In(location).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, location)
+}
+
+func normalizeDateRange(low, high, minValue, maxValue time.Time, location *time.Location) [2]time.Time {
+	clamp := func(t time.Time) time.Time {
+		t = truncateToDate(t, location)
+		if t.Before(minValue) {
+			return minValue
+		}
+		if t.After(maxValue) {
+			return maxValue
+		}
+		return t
+	}
+	low, high = clamp(low), clamp(high)
+	if low.After(high) {
+		return [2]time.Time{high, low}
+	}
+	return [2]time.Time{low, high}
+}
+
+func convertStateToDateRangeSliderProto(state *state.DateRangeSliderState) *widgetv1.DateRangeSlider {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.DateRangeSlider{
+		LowValue:         state.LowValue.Format(time.DateOnly),
+		HighValue:        state.HighValue.Format(time.DateOnly),
+		Label:            state.Label,
+		DefaultLowValue:  state.DefaultLowValue.Format(time.DateOnly),
+		DefaultHighValue: state.DefaultHighValue.Format(time.DateOnly),
+		MinValue:         state.MinValue.Format(time.DateOnly),
+		MaxValue:         state.MaxValue.Format(time.DateOnly),
+		Step:             int32(state.Step),
+		Format:           state.Format,
+		Disabled:         state.Disabled,
+	}
+}
+
+func convertDateRangeSliderProtoToState(id uuid.UUID, data *widgetv1.DateRangeSlider, location *time.Location) (*state.DateRangeSliderState, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	parseDate := func(dateStr string) (time.Time, error) {
+		if dateStr == "" {
+			return time.Time{}, nil
+		}
+		t, err := time.ParseInLocation(time.DateOnly, dateStr, location)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to parse date %q: %v", dateStr, err)
+		}
+		return t, nil
+	}
+
+	var values [6]time.Time
+	for i, s := range []string{data.LowValue, data.HighValue, data.DefaultLowValue, data.DefaultHighValue, data.MinValue, data.MaxValue} {
+		t, err := parseDate(s)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = t
+	}
+
+	return &state.DateRangeSliderState{
+		ID:               id,
+		LowValue:         values[0],
+		HighValue:        values[1],
+		Label:            data.Label,
+		DefaultLowValue:  values[2],
+		DefaultHighValue: values[3],
+		MinValue:         values[4],
+		MaxValue:         values[5],
+		Step:             int(data.Step),
+		Format:           data.Format,
+		Disabled:         data.Disabled,
+		Location:         location,
+	}, nil
+}
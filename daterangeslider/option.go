@@ -0,0 +1,87 @@
+package daterangeslider
+
+import (
+	"time"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+)
+
+type Option interface {
+	Apply(*options.DateRangeSliderOptions)
+}
+
+type defaultValueOption [2]time.Time
+
+func (d defaultValueOption) Apply(opts *options.DateRangeSliderOptions) {
+	opts.DefaultValue = (*[2]time.Time)(&d)
+}
+
+func WithDefaultValue(from, to time.Time) Option {
+	return defaultValueOption{from, to}
+}
+
+type minValueOption time.Time
+
+func (m minValueOption) Apply(opts *options.DateRangeSliderOptions) {
+	opts.MinValue = (*time.Time)(&m)
+}
+
+// WithMinValue sets the first selectable date. If it is after the last
+// selectable date, the two bounds are swapped.
+func WithMinValue(value time.Time) Option {
+	return minValueOption(value)
+}
+
+type maxValueOption time.Time
+
+func (m maxValueOption) Apply(opts *options.DateRangeSliderOptions) {
+	opts.MaxValue = (*time.Time)(&m)
+}
+
+// WithMaxValue sets the last selectable date, today by default. If it is
+// before the first selectable date, the two bounds are swapped.
+func WithMaxValue(value time.Time) Option {
+	return maxValueOption(value)
+}
+
+type stepOption int
+
+func (s stepOption) Apply(opts *options.DateRangeSliderOptions) {
+	opts.Step = int(s)
+}
+
+// WithStep sets the step of the slider in days. A step that is not positive
+// falls back to 1.
+func WithStep(days int) Option {
+	return stepOption(days)
+}
+
+type formatOption string
+
+func (f formatOption) Apply(opts *options.DateRangeSliderOptions) {
+	opts.Format = string(f)
+}
+
+func WithFormat(format string) Option {
+	return formatOption(format)
+}
+
+type disabledOption bool
+
+func (d disabledOption) Apply(opts *options.DateRangeSliderOptions) {
+	opts.Disabled = bool(d)
+}
+
+func WithDisabled(disabled bool) Option {
+	return disabledOption(disabled)
+}
+
+type locationOption time.Location
+
+func (l locationOption) Apply(opts *options.DateRangeSliderOptions) {
+	opts.Location = (*time.Location)(&l)
+}
+
+func WithLocation(location time.Location) Option {
+	return locationOption(location)
+}
@@ -0,0 +1,228 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/daterangeslider"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToDateRangeSliderProto(t *testing.T) {
+	date := func(d int) time.Time { return time.Date(2025, 1, d, 0, 0, 0, 0, time.UTC) }
+	dateRangeSliderState := &state.DateRangeSliderState{
+		ID:               uuid.Must(uuid.NewV4()),
+		LowValue:         date(5),
+		HighValue:        date(10),
+		Label:            "Test DateRangeSlider",
+		DefaultLowValue:  date(1),
+		DefaultHighValue: date(31),
+		MinValue:         date(1),
+		MaxValue:         date(31),
+		Step:             7,
+		Format:           "YYYY/MM/DD",
+		Disabled:         true,
+		Location:         time.UTC,
+	}
+
+	data := convertStateToDateRangeSliderProto(dateRangeSliderState)
+
+	if data == nil {
+		t.Fatal("convertStateToDateRangeSliderProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"LowValue", data.LowValue, "2025-01-05"},
+		{"HighValue", data.HighValue, "2025-01-10"},
+		{"Label", data.Label, dateRangeSliderState.Label},
+		{"DefaultLowValue", data.DefaultLowValue, "2025-01-01"},
+		{"DefaultHighValue", data.DefaultHighValue, "2025-01-31"},
+		{"MinValue", data.MinValue, "2025-01-01"},
+		{"MaxValue", data.MaxValue, "2025-01-31"},
+		{"Step", data.Step, int32(7)},
+		{"Format", data.Format, dateRangeSliderState.Format},
+		{"Disabled", data.Disabled, dateRangeSliderState.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertDateRangeSliderProtoToState(t *testing.T) {
+	data := &widgetv1.DateRangeSlider{
+		LowValue:         "2025-01-05",
+		HighValue:        "2025-01-10",
+		Label:            "Test DateRangeSlider",
+		DefaultLowValue:  "2025-01-01",
+		DefaultHighValue: "2025-01-31",
+		MinValue:         "2025-01-01",
+		MaxValue:         "2025-01-31",
+		Step:             1,
+		Format:           "YYYY/MM/DD",
+	}
+
+	state, err := convertDateRangeSliderProtoToState(uuid.Must(uuid.NewV4()), data, time.UTC)
+	if err != nil {
+		t.Fatalf("convertDateRangeSliderProtoToState returned error: %v", err)
+	}
+	if state == nil {
+		t.Fatal("convertDateRangeSliderProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"LowValue", state.LowValue.Format(time.DateOnly), data.LowValue},
+		{"HighValue", state.HighValue.Format(time.DateOnly), data.HighValue},
+		{"Label", state.Label, data.Label},
+		{"DefaultLowValue", state.DefaultLowValue.Format(time.DateOnly), data.DefaultLowValue},
+		{"DefaultHighValue", state.DefaultHighValue.Format(time.DateOnly), data.DefaultHighValue},
+		{"MinValue", state.MinValue.Format(time.DateOnly), data.MinValue},
+		{"MaxValue", state.MaxValue.Format(time.DateOnly), data.MaxValue},
+		{"Step", state.Step, int(data.Step)},
+		{"Location", state.Location, time.UTC},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	data.LowValue = "2025/01/05"
+	if _, err := convertDateRangeSliderProtoToState(uuid.Must(uuid.NewV4()), data, time.UTC); err == nil {
+		t.Error("convertDateRangeSliderProtoToState with invalid date returned nil error")
+	}
+}
+
+func TestDateRangeSlider(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	minValue := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxValue := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+	value := builder.DateRangeSlider("Period",
+		daterangeslider.WithMinValue(minValue),
+		daterangeslider.WithMaxValue(maxValue),
+		daterangeslider.WithDefaultValue(
+			time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2025, 3, 15, 18, 30, 0, 0, time.UTC),
+		),
+		daterangeslider.WithLocation(*time.UTC),
+	)
+
+	want := [2]time.Time{minValue, time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)}
+	if !value[0].Equal(want[0]) || !value[1].Equal(want[1]) {
+		t.Errorf("DateRangeSlider value = %v, want %v", value, want)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeDateRangeSlider, []int{0})
+	state := sess.State.GetDateRangeSlider(widgetID)
+	if state == nil {
+		t.Fatal("DateRangeSlider state not found")
+	}
+	if !state.MinValue.Equal(minValue) || !state.MaxValue.Equal(maxValue) {
+		t.Errorf("DateRangeSlider bounds = [%v, %v], want [%v, %v]", state.MinValue, state.MaxValue, minValue, maxValue)
+	}
+	if state.Step != 1 {
+		t.Errorf("DateRangeSlider step = %d, want 1", state.Step)
+	}
+}
+
+func TestDateRangeSlider_InvalidBounds(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	minValue := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxValue := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+	value := builder.DateRangeSlider("Period",
+		daterangeslider.WithMinValue(maxValue),
+		daterangeslider.WithMaxValue(minValue),
+		daterangeslider.WithStep(0),
+		daterangeslider.WithLocation(*time.UTC),
+	)
+
+	want := [2]time.Time{minValue, maxValue}
+	if !value[0].Equal(want[0]) || !value[1].Equal(want[1]) {
+		t.Errorf("DateRangeSlider value = %v, want %v", value, want)
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeDateRangeSlider, []int{0})
+	sliderState := sess.State.GetDateRangeSlider(widgetID)
+	if !sliderState.MinValue.Equal(minValue) || !sliderState.MaxValue.Equal(maxValue) {
+		t.Errorf("bounds = [%v, %v], want [%v, %v]", sliderState.MinValue, sliderState.MaxValue, minValue, maxValue)
+	}
+	if sliderState.Step != 1 {
+		t.Errorf("Step = %d, want 1", sliderState.Step)
+	}
+
+	// A lower bound in the future without an upper bound ends the range there.
+	future := truncateToDate(time.Now(), time.UTC).AddDate(0, 0, 10)
+	value = builder.DateRangeSlider("Upcoming",
+		daterangeslider.WithMinValue(future),
+		daterangeslider.WithStep(-2),
+		daterangeslider.WithLocation(*time.UTC),
+	)
+	if value[0].After(value[1]) {
+		t.Errorf("DateRangeSlider value = %v, want an ordered range", value)
+	}
+	if !value[1].Equal(future) {
+		t.Errorf("DateRangeSlider high value = %v, want %v", value[1], future)
+	}
+	widgetID = builder.generatePageID(state.WidgetTypeDateRangeSlider, []int{1})
+	if step := sess.State.GetDateRangeSlider(widgetID).Step; step != 1 {
+		t.Errorf("Step = %d, want 1", step)
+	}
+}
@@ -2,6 +2,7 @@ package sourcetool
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
@@ -24,7 +25,7 @@ func (b *uiBuilder) DateTimeInput(label string, opts ...datetimeinput.Option) *t
 		Format:       "YYYY/MM/DD HH:MM:SS",
 		MaxValue:     nil,
 		MinValue:     nil,
-		Location:     time.Local,
+		Location:     b.location(),
 	}
 
 	for _, o := range opts {
@@ -52,6 +53,11 @@ func (b *uiBuilder) DateTimeInput(label string, opts ...datetimeinput.Option) *t
 			ID:    widgetID,
 			Value: dateTimeInputOpts.DefaultValue,
 		}
+		if v, ok := b.lookupQueryParam(dateTimeInputOpts.QueryParam); ok {
+			if t, err := time.ParseInLocation(time.DateTime, v[0], dateTimeInputOpts.Location); err == nil {
+				dateTimeInputState.Value = &t
+			}
+		}
 	}
 	dateTimeInputState.Label = dateTimeInputOpts.Label
 	dateTimeInputState.Placeholder = dateTimeInputOpts.Placeholder
@@ -64,6 +70,9 @@ func (b *uiBuilder) DateTimeInput(label string, opts ...datetimeinput.Option) *t
 	dateTimeInputState.Location = dateTimeInputOpts.Location
 	sess.State.Set(widgetID, dateTimeInputState)
 
+	values := formatTimeParam(dateTimeInputState.Value, time.DateTime, dateTimeInputOpts.Location)
+	b.bindQueryParam(dateTimeInputOpts.QueryParam, values, slices.Equal(values, formatTimeParam(dateTimeInputOpts.DefaultValue, time.DateTime, dateTimeInputOpts.Location)))
+
 	dateTimeInput := convertStateToDateTimeInputProto(dateTimeInputState)
 	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
 		SessionId: sess.ID.String(),
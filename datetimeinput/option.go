@@ -89,3 +89,16 @@ func (l locationOption) Apply(opts *options.DateTimeInputOptions) {
 func WithLocation(location time.Location) Option {
 	return locationOption(location)
 }
+
+type queryParamOption string
+
+func (q queryParamOption) Apply(opts *options.DateTimeInputOptions) {
+	opts.QueryParam = string(q)
+}
+
+// WithQueryParam binds the value to the key query parameter of the page URL.
+// The parameter sets the initial value and is updated when the value changes.
+// The time is written as "2006-01-02 15:04:05" in the input location.
+func WithQueryParam(key string) Option {
+	return queryParamOption(key)
+}
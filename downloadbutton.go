@@ -0,0 +1,169 @@
+package sourcetool
+
+import (
+	"io"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/downloadbutton"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+const downloadChunkSize = 64 << 10
+
+func (b *uiBuilder) DownloadButton(label, filename, mimeType string, generate func(io.Writer) error, opts ...downloadbutton.Option) error {
+	downloadButtonOpts := &options.DownloadButtonOptions{
+		Label:    label,
+		Filename: filename,
+		MimeType: mimeType,
+		Disabled: false,
+	}
+
+	for _, o := range opts {
+		o.Apply(downloadButtonOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return nil
+	}
+	page := b.page
+	if page == nil {
+		return nil
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return nil
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeDownloadButton, path)
+	downloadButtonState := sess.State.GetDownloadButton(widgetID)
+	if downloadButtonState == nil {
+		downloadButtonState = &state.DownloadButtonState{
+			ID:    widgetID,
+			Value: false,
+		}
+	}
+	downloadButtonState.Label = downloadButtonOpts.Label
+	downloadButtonState.Filename = downloadButtonOpts.Filename
+	downloadButtonState.MimeType = downloadButtonOpts.MimeType
+	downloadButtonState.Disabled = downloadButtonOpts.Disabled
+	sess.State.Set(widgetID, downloadButtonState)
+
+	downloadButton := convertStateToDownloadButtonProto(downloadButtonState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_DownloadButton{
+				DownloadButton: downloadButton,
+			},
+		},
+	})
+
+	cursor.next()
+
+	if !downloadButtonState.Value || downloadButtonState.Disabled {
+		return nil
+	}
+
+	downloadID := uuid.Must(uuid.NewV4())
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.DownloadStart{
+		SessionId:  sess.ID.String(),
+		WidgetId:   widgetID.String(),
+		DownloadId: downloadID.String(),
+		Filename:   downloadButtonState.Filename,
+		MimeType:   downloadButtonState.MimeType,
+	})
+
+	w := &downloadWriter{
+		runtime:    b.runtime,
+		sessionID:  sess.ID,
+		downloadID: downloadID,
+		buf:        make([]byte, 0, downloadChunkSize),
+	}
+	err := generate(w)
+	w.close(err)
+
+	return err
+}
+
+// downloadWriter buffers generated content and sends it to the browser in
+// DownloadChunk messages of at most downloadChunkSize bytes.
+type downloadWriter struct {
+	runtime    *runtime
+	sessionID  uuid.UUID
+	downloadID uuid.UUID
+	offset     int64
+	buf        []byte
+}
+
+func (w *downloadWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		size := min(downloadChunkSize-len(w.buf), len(p))
+		w.buf = append(w.buf, p[:size]...)
+		p = p[size:]
+		if len(w.buf) == downloadChunkSize {
+			w.flush(false, "")
+		}
+	}
+	return n, nil
+}
+
+func (w *downloadWriter) flush(last bool, errMsg string) {
+	data := make([]byte, len(w.buf))
+	copy(data, w.buf)
+	w.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.DownloadChunk{
+		SessionId:  w.sessionID.String(),
+		DownloadId: w.downloadID.String(),
+		Offset:     w.offset,
+		Data:       data,
+		Last:       last,
+		Error:      errMsg,
+	})
+	w.offset += int64(len(data))
+	w.buf = w.buf[:0]
+}
+
+func (w *downloadWriter) close(err error) {
+	if err != nil {
+		w.buf = w.buf[:0]
+		w.flush(true, err.Error())
+		return
+	}
+	w.flush(true, "")
+}
+
+func convertStateToDownloadButtonProto(state *state.DownloadButtonState) *widgetv1.DownloadButton {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.DownloadButton{
+		Value:    state.Value,
+		Label:    state.Label,
+		Filename: state.Filename,
+		MimeType: state.MimeType,
+		Disabled: state.Disabled,
+	}
+}
+
+func convertDownloadButtonProtoToState(id uuid.UUID, data *widgetv1.DownloadButton) *state.DownloadButtonState {
+	if data == nil {
+		return nil
+	}
+	return &state.DownloadButtonState{
+		ID:       id,
+		Value:    data.Value,
+		Label:    data.Label,
+		Filename: data.Filename,
+		MimeType: data.MimeType,
+		Disabled: data.Disabled,
+	}
+}
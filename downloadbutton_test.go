@@ -0,0 +1,262 @@
+package sourcetool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/downloadbutton"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToDownloadButtonProto(t *testing.T) {
+	downloadButtonState := &state.DownloadButtonState{
+		ID:       uuid.Must(uuid.NewV4()),
+		Value:    true,
+		Label:    "Download",
+		Filename: "report.csv",
+		MimeType: "text/csv",
+		Disabled: true,
+	}
+
+	data := convertStateToDownloadButtonProto(downloadButtonState)
+
+	if data == nil {
+		t.Fatal("convertStateToDownloadButtonProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Value", data.Value, downloadButtonState.Value},
+		{"Label", data.Label, downloadButtonState.Label},
+		{"Filename", data.Filename, downloadButtonState.Filename},
+		{"MimeType", data.MimeType, downloadButtonState.MimeType},
+		{"Disabled", data.Disabled, downloadButtonState.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertDownloadButtonProtoToState(t *testing.T) {
+	data := &widgetv1.DownloadButton{
+		Value:    true,
+		Label:    "Download",
+		Filename: "report.csv",
+		MimeType: "text/csv",
+		Disabled: true,
+	}
+
+	state := convertDownloadButtonProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertDownloadButtonProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Value", state.Value, data.Value},
+		{"Label", state.Label, data.Label},
+		{"Filename", state.Filename, data.Filename},
+		{"MimeType", state.MimeType, data.MimeType},
+		{"Disabled", state.Disabled, data.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDownloadButton(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	called := false
+	err := builder.DownloadButton("Download", "report.csv", "text/csv", func(w io.Writer) error {
+		called = true
+		return nil
+	}, downloadbutton.WithDisabled(false))
+	if err != nil {
+		t.Fatalf("DownloadButton returned error: %v", err)
+	}
+	if called {
+		t.Error("content generated before the button was clicked")
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	if v := messages[0].GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeDownloadButton, []int{0})
+	state := sess.State.GetDownloadButton(widgetID)
+	if state == nil {
+		t.Fatal("DownloadButton state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Value", state.Value, false},
+		{"Label", state.Label, "Download"},
+		{"Filename", state.Filename, "report.csv"},
+		{"MimeType", state.MimeType, "text/csv"},
+		{"Disabled", state.Disabled, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDownloadButton_Clicked(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeDownloadButton, []int{0})
+	sess.State.Set(widgetID, &state.DownloadButtonState{ID: widgetID, Value: true})
+
+	content := bytes.Repeat([]byte("a"), downloadChunkSize+10)
+	err := builder.DownloadButton("Download", "report.txt", "text/plain", func(w io.Writer) error {
+		_, err := w.Write(content)
+		return err
+	})
+	if err != nil {
+		t.Fatalf("DownloadButton returned error: %v", err)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 4 {
+		t.Fatalf("WebSocket messages count = %d, want 4", len(messages))
+	}
+
+	start := messages[1].GetDownloadStart()
+	if start == nil {
+		t.Fatal("WebSocket message type = nil, want DownloadStart")
+	}
+	if start.Filename != "report.txt" {
+		t.Errorf("Filename = %v, want %v", start.Filename, "report.txt")
+	}
+
+	var received []byte
+	for i, msg := range messages[2:] {
+		chunk := msg.GetDownloadChunk()
+		if chunk == nil {
+			t.Fatalf("message %d type = nil, want DownloadChunk", i+2)
+		}
+		if chunk.DownloadId != start.DownloadId {
+			t.Errorf("DownloadId = %v, want %v", chunk.DownloadId, start.DownloadId)
+		}
+		if chunk.Offset != int64(len(received)) {
+			t.Errorf("Offset = %d, want %d", chunk.Offset, len(received))
+		}
+		received = append(received, chunk.Data...)
+	}
+	if !messages[3].GetDownloadChunk().Last {
+		t.Error("final chunk Last = false, want true")
+	}
+	if !bytes.Equal(received, content) {
+		t.Errorf("received %d bytes, want %d", len(received), len(content))
+	}
+}
+
+func TestDownloadButton_GenerateError(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeDownloadButton, []int{0})
+	sess.State.Set(widgetID, &state.DownloadButtonState{ID: widgetID, Value: true})
+
+	wantErr := errors.New("query failed")
+	err := builder.DownloadButton("Download", "report.csv", "text/csv", func(w io.Writer) error {
+		return wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("DownloadButton error = %v, want %v", err, wantErr)
+	}
+
+	messages := mockWS.Messages()
+	chunk := messages[len(messages)-1].GetDownloadChunk()
+	if chunk == nil {
+		t.Fatal("WebSocket message type = nil, want DownloadChunk")
+	}
+	if !chunk.Last || chunk.Error != wantErr.Error() {
+		t.Errorf("final chunk = {Last: %v, Error: %q}, want {Last: true, Error: %q}", chunk.Last, chunk.Error, wantErr.Error())
+	}
+}
@@ -0,0 +1,32 @@
+package sourcetool
+
+// Slot is a placeholder reserved by UIBuilder.Empty. Every widget rendered
+// through a Slot is placed at the reserved path, replacing whatever the slot
+// showed before, so a handler can rewrite it while the page is still running.
+type Slot interface {
+	UIBuilder
+}
+
+func (b *uiBuilder) Empty() Slot {
+	slot := &uiBuilder{
+		runtime: b.runtime,
+		context: b.context,
+		session: b.session,
+		page:    b.page,
+	}
+
+	if b.cursor == nil {
+		return slot
+	}
+	path := b.cursor.getPath()
+
+	slot.cursor = &cursor{
+		parentPath: path[:len(path)-1],
+		index:      path[len(path)-1],
+		pinned:     true,
+	}
+
+	b.cursor.next()
+
+	return slot
+}
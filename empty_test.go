@@ -0,0 +1,98 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestEmpty(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	slot := builder.Empty()
+	builder.Markdown("below the slot")
+
+	if got := builder.cursor.getPath(); len(got) != 1 || got[0] != 2 {
+		t.Errorf("cursor path after Empty and Markdown = %v, want [2]", got)
+	}
+
+	slot.Markdown("line 1")
+	slot.Markdown("line 2")
+
+	messages := mockWS.Messages()
+	if len(messages) != 3 {
+		t.Fatalf("WebSocket messages count = %d, want 3", len(messages))
+	}
+
+	first := messages[1].GetRenderWidget()
+	second := messages[2].GetRenderWidget()
+	if first == nil || second == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+	if len(second.Path) != 1 || second.Path[0] != 0 {
+		t.Errorf("slot widget path = %v, want [0]", second.Path)
+	}
+	if first.Widget.Id != second.Widget.Id {
+		t.Errorf("slot widget ID = %v, want %v", second.Widget.Id, first.Widget.Id)
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeMarkdown, []int{0})
+	state := sess.State.GetMarkdown(widgetID)
+	if state == nil {
+		t.Fatal("Markdown state not found")
+	}
+	if state.Body != "line 2" {
+		t.Errorf("Body = %v, want %v", state.Body, "line 2")
+	}
+}
+
+func TestEmpty_Nested(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	builder.Markdown("header")
+	cols := builder.Columns(2)
+	slot := cols[1].Empty()
+	slot.Table([]map[string]any{{"id": 1}})
+
+	widgetID := builder.generatePageID(state.WidgetTypeTable, []int{1, 1, 0})
+	if sess.State.GetTable(widgetID) == nil {
+		t.Fatal("Table state at slot path not found")
+	}
+}
@@ -0,0 +1,223 @@
+package sourcetool
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/fileuploader"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) FileUploader(label string, opts ...fileuploader.Option) []*fileuploader.UploadedFile {
+	fileUploaderOpts := &options.FileUploaderOptions{
+		Label:    label,
+		Accept:   nil,
+		MaxSize:  nil,
+		Multiple: false,
+		Required: false,
+		Disabled: false,
+	}
+
+	for _, o := range opts {
+		o.Apply(fileUploaderOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return nil
+	}
+	page := b.page
+	if page == nil {
+		return nil
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return nil
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeFileUploader, path)
+	fileUploaderState := sess.State.GetFileUploader(widgetID)
+	if fileUploaderState == nil {
+		fileUploaderState = &state.FileUploaderState{
+			ID:    widgetID,
+			Value: []state.FileUploaderStateFile{},
+		}
+	}
+	fileUploaderState.Label = fileUploaderOpts.Label
+	fileUploaderState.Accept = fileUploaderOpts.Accept
+	fileUploaderState.MaxSize = fileUploaderOpts.MaxSize
+	fileUploaderState.Multiple = fileUploaderOpts.Multiple
+	fileUploaderState.Required = fileUploaderOpts.Required
+	fileUploaderState.Disabled = fileUploaderOpts.Disabled
+	sess.State.Set(widgetID, fileUploaderState)
+
+	fileUploader := convertStateToFileUploaderProto(fileUploaderState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_FileUploader{
+				FileUploader: fileUploader,
+			},
+		},
+	})
+
+	cursor.next()
+
+	fileIDs := make([]uuid.UUID, 0, len(fileUploaderState.Value))
+	files := make([]*fileuploader.UploadedFile, 0, len(fileUploaderState.Value))
+	for _, f := range fileUploaderState.Value {
+		fileIDs = append(fileIDs, f.ID)
+		upload := sess.Uploads.Get(f.ID)
+		if upload == nil || !upload.Complete() {
+			continue
+		}
+		files = append(files, &fileuploader.UploadedFile{
+			Reader:   bytes.NewReader(upload.Bytes()),
+			ID:       f.ID.String(),
+			Name:     upload.Name,
+			MimeType: upload.MimeType,
+			Size:     upload.Size,
+		})
+	}
+	sess.Uploads.Retain(widgetID, fileIDs)
+
+	return files
+}
+
+func startFileUpload(sess *session.Session, msg *websocketv1.FileUploadStart) error {
+	widgetID, err := uuid.FromString(msg.WidgetId)
+	if err != nil {
+		return err
+	}
+	fileID, err := uuid.FromString(msg.FileId)
+	if err != nil {
+		return err
+	}
+	if msg.Size < 0 {
+		return fmt.Errorf("invalid file size: %d", msg.Size)
+	}
+
+	fileUploaderState := sess.State.GetFileUploader(widgetID)
+	if fileUploaderState == nil {
+		return fmt.Errorf("file uploader not found: %s", widgetID)
+	}
+	if fileUploaderState.Disabled {
+		return fmt.Errorf("file uploader is disabled: %s", widgetID)
+	}
+	if fileUploaderState.MaxSize != nil && msg.Size > *fileUploaderState.MaxSize {
+		return fmt.Errorf("file %q is %d bytes, exceeding the maximum of %d bytes", msg.Name, msg.Size, *fileUploaderState.MaxSize)
+	}
+	if !acceptsFile(fileUploaderState.Accept, msg.Name, msg.MimeType) {
+		return fmt.Errorf("file %q of type %q is not accepted by file uploader %s", msg.Name, msg.MimeType, widgetID)
+	}
+
+	upload := &session.Upload{
+		ID:       fileID,
+		WidgetID: widgetID,
+		Name:     msg.Name,
+		MimeType: msg.MimeType,
+		Size:     msg.Size,
+	}
+	// A single file uploader keeps only the latest file, so a new upload
+	// replaces the earlier one even if it never finished.
+	if fileUploaderState.Multiple {
+		sess.Uploads.Start(upload)
+	} else {
+		sess.Uploads.Replace(upload)
+	}
+
+	return nil
+}
+
+// acceptsFile reports whether a file matches one of the accept entries, which
+// follow the HTML accept attribute: a file extension such as ".csv", a MIME
+// type such as "text/csv", or a wildcard such as "image/*". An empty accept
+// list allows any file.
+func acceptsFile(accept []string, name, mimeType string) bool {
+	if len(accept) == 0 {
+		return true
+	}
+	ext := strings.ToLower(filepath.Ext(name))
+	mimeType = strings.ToLower(mimeType)
+	for _, a := range accept {
+		a = strings.ToLower(strings.TrimSpace(a))
+		switch {
+		case strings.HasPrefix(a, "."):
+			if ext == a {
+				return true
+			}
+		case strings.HasSuffix(a, "/*"):
+			if strings.HasPrefix(mimeType, strings.TrimSuffix(a, "*")) {
+				return true
+			}
+		case a == mimeType:
+			return true
+		}
+	}
+	return false
+}
+
+func convertStateToFileUploaderProto(state *state.FileUploaderState) *widgetv1.FileUploader {
+	if state == nil {
+		return nil
+	}
+	value := make([]*widgetv1.UploadedFile, len(state.Value))
+	for i, f := range state.Value {
+		value[i] = &widgetv1.UploadedFile{
+			Id:       f.ID.String(),
+			Name:     f.Name,
+			MimeType: f.MimeType,
+			Size:     f.Size,
+		}
+	}
+	return &widgetv1.FileUploader{
+		Value:    value,
+		Label:    state.Label,
+		Accept:   state.Accept,
+		MaxSize:  state.MaxSize,
+		Multiple: state.Multiple,
+		Required: state.Required,
+		Disabled: state.Disabled,
+	}
+}
+
+func convertFileUploaderProtoToState(id uuid.UUID, data *widgetv1.FileUploader) (*state.FileUploaderState, error) {
+	if data == nil {
+		return nil, nil
+	}
+	value := make([]state.FileUploaderStateFile, len(data.Value))
+	for i, f := range data.Value {
+		fileID, err := uuid.FromString(f.Id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse file id %q: %v", f.Id, err)
+		}
+		value[i] = state.FileUploaderStateFile{
+			ID:       fileID,
+			Name:     f.Name,
+			MimeType: f.MimeType,
+			Size:     f.Size,
+		}
+	}
+	return &state.FileUploaderState{
+		ID:       id,
+		Value:    value,
+		Label:    data.Label,
+		Accept:   data.Accept,
+		MaxSize:  data.MaxSize,
+		Multiple: data.Multiple,
+		Required: data.Required,
+		Disabled: data.Disabled,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package fileuploader
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.FileUploaderOptions)
+}
+
+type acceptOption []string
+
+func (a acceptOption) Apply(opts *options.FileUploaderOptions) {
+	opts.Accept = []string(a)
+}
+
+func WithAccept(types ...string) Option {
+	return acceptOption(types)
+}
+
+type maxSizeOption int64
+
+func (m maxSizeOption) Apply(opts *options.FileUploaderOptions) {
+	opts.MaxSize = (*int64)(&m)
+}
+
+func WithMaxSize(bytes int64) Option {
+	return maxSizeOption(bytes)
+}
+
+type multipleOption bool
+
+func (m multipleOption) Apply(opts *options.FileUploaderOptions) {
+	opts.Multiple = bool(m)
+}
+
+func WithMultiple(multiple bool) Option {
+	return multipleOption(multiple)
+}
+
+type requiredOption bool
+
+func (r requiredOption) Apply(opts *options.FileUploaderOptions) {
+	opts.Required = bool(r)
+}
+
+func WithRequired(required bool) Option {
+	return requiredOption(required)
+}
+
+type disabledOption bool
+
+func (d disabledOption) Apply(opts *options.FileUploaderOptions) {
+	opts.Disabled = bool(d)
+}
+
+func WithDisabled(disabled bool) Option {
+	return disabledOption(disabled)
+}
@@ -0,0 +1,13 @@
+package fileuploader
+
+import "io"
+
+// UploadedFile is a file received from the browser. It reads the uploaded
+// content from the beginning each time the page runs.
+type UploadedFile struct {
+	io.Reader
+	ID       string
+	Name     string
+	MimeType string
+	Size     int64
+}
@@ -0,0 +1,366 @@
+package sourcetool
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/fileuploader"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToFileUploaderProto(t *testing.T) {
+	maxSize := int64(1024)
+	fileID := uuid.Must(uuid.NewV4())
+
+	fileUploaderState := &state.FileUploaderState{
+		ID: uuid.Must(uuid.NewV4()),
+		Value: []state.FileUploaderStateFile{
+			{ID: fileID, Name: "users.csv", MimeType: "text/csv", Size: 42},
+		},
+		Label:    "Import",
+		Accept:   []string{".csv"},
+		MaxSize:  &maxSize,
+		Multiple: true,
+		Required: true,
+		Disabled: true,
+	}
+
+	data := convertStateToFileUploaderProto(fileUploaderState)
+
+	if data == nil {
+		t.Fatal("convertStateToFileUploaderProto returned nil")
+	}
+	if len(data.Value) != 1 {
+		t.Fatalf("Value length = %d, want 1", len(data.Value))
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", data.Label, fileUploaderState.Label},
+		{"Accept", data.Accept[0], fileUploaderState.Accept[0]},
+		{"MaxSize", data.GetMaxSize(), maxSize},
+		{"Multiple", data.Multiple, fileUploaderState.Multiple},
+		{"Required", data.Required, fileUploaderState.Required},
+		{"Disabled", data.Disabled, fileUploaderState.Disabled},
+		{"FileID", data.Value[0].Id, fileID.String()},
+		{"FileName", data.Value[0].Name, "users.csv"},
+		{"FileMimeType", data.Value[0].MimeType, "text/csv"},
+		{"FileSize", data.Value[0].Size, int64(42)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertFileUploaderProtoToState(t *testing.T) {
+	fileID := uuid.Must(uuid.NewV4())
+	data := &widgetv1.FileUploader{
+		Value: []*widgetv1.UploadedFile{
+			{Id: fileID.String(), Name: "users.csv", MimeType: "text/csv", Size: 42},
+		},
+		Label:    "Import",
+		Multiple: true,
+	}
+
+	state, err := convertFileUploaderProtoToState(uuid.Must(uuid.NewV4()), data)
+	if err != nil {
+		t.Fatalf("convertFileUploaderProtoToState returned error: %v", err)
+	}
+	if state == nil {
+		t.Fatal("convertFileUploaderProtoToState returned nil")
+	}
+	if len(state.Value) != 1 {
+		t.Fatalf("Value length = %d, want 1", len(state.Value))
+	}
+	if state.Value[0].ID != fileID {
+		t.Errorf("FileID = %v, want %v", state.Value[0].ID, fileID)
+	}
+	if state.Label != data.Label {
+		t.Errorf("Label = %v, want %v", state.Label, data.Label)
+	}
+	if state.Multiple != data.Multiple {
+		t.Errorf("Multiple = %v, want %v", state.Multiple, data.Multiple)
+	}
+
+	data.Value[0].Id = "invalid"
+	if _, err := convertFileUploaderProtoToState(uuid.Must(uuid.NewV4()), data); err == nil {
+		t.Error("convertFileUploaderProtoToState with invalid file id returned nil error")
+	}
+}
+
+func TestFileUploader(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	files := builder.FileUploader("Import",
+		fileuploader.WithAccept(".csv", "text/csv"),
+		fileuploader.WithMaxSize(1024),
+		fileuploader.WithMultiple(true),
+	)
+	if len(files) != 0 {
+		t.Errorf("FileUploader files = %d, want 0", len(files))
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	if v := messages[0].GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeFileUploader, []int{0})
+	state := sess.State.GetFileUploader(widgetID)
+	if state == nil {
+		t.Fatal("FileUploader state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, "Import"},
+		{"Accept", len(state.Accept), 2},
+		{"MaxSize", *state.MaxSize, int64(1024)},
+		{"Multiple", state.Multiple, true},
+		{"Required", state.Required, false},
+		{"Disabled", state.Disabled, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuntime_FileUpload(t *testing.T) {
+	pageID := uuid.Must(uuid.NewV4())
+	sessionID := uuid.Must(uuid.NewV4())
+
+	var got []*fileuploader.UploadedFile
+	pages := map[uuid.UUID]*page{
+		pageID: {
+			id: pageID,
+			handler: func(ui UIBuilder) error {
+				got = ui.FileUploader("Import", fileuploader.WithMaxSize(16))
+				return nil
+			},
+		},
+	}
+
+	mockClient := mock.NewClient()
+	r := &runtime{
+		wsClient:       mockClient,
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(pages),
+	}
+	mockClient.RegisterHandler(func(msg *websocketv1.Message) error {
+		switch m := msg.Type.(type) {
+		case *websocketv1.Message_RerunPage:
+			return r.handleRerunPage(m.RerunPage)
+		case *websocketv1.Message_FileUploadStart:
+			return r.handleFileUploadStart(m.FileUploadStart)
+		case *websocketv1.Message_FileUploadChunk:
+			return r.handleFileUploadChunk(m.FileUploadChunk)
+		}
+		return nil
+	})
+
+	sess := session.New(sessionID, pageID)
+	r.sessionManager.SetSession(sess)
+
+	mockClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RerunPage{
+		SessionId: sessionID.String(),
+		PageId:    pageID.String(),
+	})
+
+	ui := &uiBuilder{page: pages[pageID]}
+	widgetID := ui.generatePageID(state.WidgetTypeFileUploader, []int{0})
+	fileID := uuid.Must(uuid.NewV4())
+
+	if err := r.handleFileUploadStart(&websocketv1.FileUploadStart{
+		SessionId: sessionID.String(),
+		WidgetId:  widgetID.String(),
+		FileId:    uuid.Must(uuid.NewV4()).String(),
+		Name:      "too-big.csv",
+		Size:      17,
+	}); err == nil {
+		t.Error("handleFileUploadStart with oversized file returned nil error")
+	}
+
+	mockClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.FileUploadStart{
+		SessionId: sessionID.String(),
+		WidgetId:  widgetID.String(),
+		FileId:    fileID.String(),
+		Name:      "users.csv",
+		MimeType:  "text/csv",
+		Size:      11,
+	})
+	mockClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.FileUploadChunk{
+		SessionId: sessionID.String(),
+		FileId:    fileID.String(),
+		Offset:    0,
+		Data:      []byte("id,name\n"),
+	})
+	mockClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.FileUploadChunk{
+		SessionId: sessionID.String(),
+		FileId:    fileID.String(),
+		Offset:    8,
+		Data:      []byte("1,a"),
+		Last:      true,
+	})
+
+	mockClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RerunPage{
+		SessionId: sessionID.String(),
+		PageId:    pageID.String(),
+		States: []*widgetv1.Widget{
+			{
+				Id: widgetID.String(),
+				Type: &widgetv1.Widget_FileUploader{
+					FileUploader: &widgetv1.FileUploader{
+						Value: []*widgetv1.UploadedFile{
+							{Id: fileID.String(), Name: "users.csv", MimeType: "text/csv", Size: 11},
+						},
+						Label: "Import",
+					},
+				},
+			},
+		},
+	})
+
+	if len(got) != 1 {
+		t.Fatalf("uploaded files = %d, want 1", len(got))
+	}
+	content, err := io.ReadAll(got[0])
+	if err != nil {
+		t.Fatalf("failed to read uploaded file: %v", err)
+	}
+	if string(content) != "id,name\n1,a" {
+		t.Errorf("uploaded content = %q, want %q", content, "id,name\n1,a")
+	}
+	if got[0].Name != "users.csv" {
+		t.Errorf("Name = %v, want %v", got[0].Name, "users.csv")
+	}
+}
+
+func TestAcceptsFile(t *testing.T) {
+	tests := []struct {
+		name     string
+		accept   []string
+		fileName string
+		mimeType string
+		want     bool
+	}{
+		{"No restriction", nil, "a.exe", "application/octet-stream", true},
+		{"Extension", []string{".csv"}, "users.CSV", "", true},
+		{"Extension mismatch", []string{".csv"}, "users.xlsx", "", false},
+		{"MIME type", []string{"text/csv"}, "users", "text/csv", true},
+		{"MIME wildcard", []string{"image/*"}, "logo.png", "image/png", true},
+		{"Rejected", []string{".csv", "image/*"}, "report.pdf", "application/pdf", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := acceptsFile(tt.accept, tt.fileName, tt.mimeType); got != tt.want {
+				t.Errorf("acceptsFile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStartFileUpload_Restrictions(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+	builder.FileUploader("Import", fileuploader.WithAccept(".csv"))
+	widgetID := builder.generatePageID(state.WidgetTypeFileUploader, []int{0})
+
+	start := func(name string) (uuid.UUID, error) {
+		fileID := uuid.Must(uuid.NewV4())
+		return fileID, startFileUpload(sess, &websocketv1.FileUploadStart{
+			SessionId: sessionID.String(),
+			WidgetId:  widgetID.String(),
+			FileId:    fileID.String(),
+			Name:      name,
+			Size:      1,
+		})
+	}
+
+	if _, err := start("report.pdf"); err == nil {
+		t.Error("startFileUpload with a disallowed type returned nil error")
+	}
+
+	// An interrupted upload does not block the next one.
+	interruptedID, err := start("interrupted.csv")
+	if err != nil {
+		t.Fatalf("startFileUpload returned error: %v", err)
+	}
+	firstID, err := start("first.csv")
+	if err != nil {
+		t.Fatalf("startFileUpload after an interrupted upload returned error: %v", err)
+	}
+	if sess.Uploads.Get(interruptedID) != nil {
+		t.Error("interrupted upload was not replaced")
+	}
+	if err := sess.Uploads.Append(firstID, 0, []byte("a"), true); err != nil {
+		t.Fatalf("Append returned error: %v", err)
+	}
+
+	// A completed file is replaced before the next page run.
+	secondID, err := start("second.csv")
+	if err != nil {
+		t.Fatalf("startFileUpload replacing a completed file returned error: %v", err)
+	}
+	if sess.Uploads.Get(firstID) != nil {
+		t.Error("completed upload was not replaced")
+	}
+	if sess.Uploads.Get(secondID) == nil {
+		t.Error("replacing upload was not started")
+	}
+}
@@ -68,6 +68,7 @@ func (b *uiBuilder) Form(buttonLabel string, opts ...form.Option) (UIBuilder, bo
 
 	childBuilder := &uiBuilder{
 		runtime: b.runtime,
+		context: b.context,
 		session: sess,
 		page:    page,
 		cursor:  childCursor,
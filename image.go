@@ -0,0 +1,113 @@
+package sourcetool
+
+import (
+	"bytes"
+	stdimage "image"
+	"image/png"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/image"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+func (b *uiBuilder) Image(src any, opts ...image.Option) error {
+	imageOpts := &options.ImageOptions{
+		Caption:  "",
+		Width:    nil,
+		MimeType: "",
+	}
+
+	for _, o := range opts {
+		o.Apply(imageOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return nil
+	}
+	page := b.page
+	if page == nil {
+		return nil
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return nil
+	}
+	path := cursor.getPath()
+
+	if img, ok := src.(stdimage.Image); ok {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, img); err != nil {
+			return err
+		}
+		src = buf.Bytes()
+		imageOpts.MimeType = "image/png"
+	}
+
+	url, mediaID, mimeType, err := b.resolveMediaSource(src, imageOpts.MimeType)
+	if err != nil {
+		return err
+	}
+
+	widgetID := b.generatePageID(state.WidgetTypeImage, path)
+	imageState := sess.State.GetImage(widgetID)
+	if imageState == nil {
+		imageState = &state.ImageState{
+			ID: widgetID,
+		}
+	}
+	imageState.Src = url
+	imageState.MediaID = mediaID
+	imageState.MimeType = mimeType
+	imageState.Caption = imageOpts.Caption
+	imageState.Width = imageOpts.Width
+	sess.State.Set(widgetID, imageState)
+
+	imageProto := convertStateToImageProto(imageState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Image{
+				Image: imageProto,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return nil
+}
+
+func convertStateToImageProto(state *state.ImageState) *widgetv1.Image {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Image{
+		Src:      state.Src,
+		MediaId:  state.MediaID,
+		MimeType: state.MimeType,
+		Caption:  state.Caption,
+		Width:    state.Width,
+	}
+}
+
+func convertImageProtoToState(id uuid.UUID, data *widgetv1.Image) *state.ImageState {
+	if data == nil {
+		return nil
+	}
+	return &state.ImageState{
+		ID:       id,
+		Src:      data.Src,
+		MediaID:  data.MediaId,
+		MimeType: data.MimeType,
+		Caption:  data.Caption,
+		Width:    data.Width,
+	}
+}
@@ -0,0 +1,37 @@
+package image
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.ImageOptions)
+}
+
+type captionOption string
+
+func (c captionOption) Apply(opts *options.ImageOptions) {
+	opts.Caption = string(c)
+}
+
+func WithCaption(caption string) Option {
+	return captionOption(caption)
+}
+
+type widthOption int32
+
+func (w widthOption) Apply(opts *options.ImageOptions) {
+	opts.Width = (*int32)(&w)
+}
+
+func WithWidth(width int32) Option {
+	return widthOption(width)
+}
+
+type mimeTypeOption string
+
+func (m mimeTypeOption) Apply(opts *options.ImageOptions) {
+	opts.MimeType = string(m)
+}
+
+func WithMimeType(mimeType string) Option {
+	return mimeTypeOption(mimeType)
+}
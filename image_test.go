@@ -0,0 +1,278 @@
+package sourcetool
+
+import (
+	"bytes"
+	"context"
+	stdimage "image"
+	"image/color"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/image"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToImageProto(t *testing.T) {
+	width := int32(320)
+	imageState := &state.ImageState{
+		ID:       uuid.Must(uuid.NewV4()),
+		Src:      "https://example.com/product.png",
+		MediaID:  "abc",
+		MimeType: "image/png",
+		Caption:  "Product",
+		Width:    &width,
+	}
+
+	data := convertStateToImageProto(imageState)
+
+	if data == nil {
+		t.Fatal("convertStateToImageProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Src", data.Src, imageState.Src},
+		{"MediaId", data.MediaId, imageState.MediaID},
+		{"MimeType", data.MimeType, imageState.MimeType},
+		{"Caption", data.Caption, imageState.Caption},
+		{"Width", data.GetWidth(), width},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertImageProtoToState(t *testing.T) {
+	width := int32(320)
+	data := &widgetv1.Image{
+		Src:      "https://example.com/product.png",
+		MediaId:  "abc",
+		MimeType: "image/png",
+		Caption:  "Product",
+		Width:    &width,
+	}
+
+	state := convertImageProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertImageProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Src", state.Src, data.Src},
+		{"MediaID", state.MediaID, data.MediaId},
+		{"MimeType", state.MimeType, data.MimeType},
+		{"Caption", state.Caption, data.Caption},
+		{"Width", *state.Width, width},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImage(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	src := "https://example.com/product.png"
+	if err := builder.Image(src, image.WithCaption("Product"), image.WithWidth(200)); err != nil {
+		t.Fatalf("Image returned error: %v", err)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	if v := messages[0].GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeImage, []int{0})
+	state := sess.State.GetImage(widgetID)
+	if state == nil {
+		t.Fatal("Image state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Src", state.Src, src},
+		{"MediaID", state.MediaID, ""},
+		{"Caption", state.Caption, "Product"},
+		{"Width", *state.Width, int32(200)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImage_InlineBytes(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	newBuilder := func() *uiBuilder {
+		return &uiBuilder{
+			context: context.Background(),
+			session: sess,
+			cursor:  newCursor(),
+			page: &page{
+				id: pageID,
+			},
+			runtime: &runtime{
+				wsClient: mockWS,
+			},
+		}
+	}
+
+	data := bytes.Repeat([]byte{0x89, 'P', 'N', 'G'}, mediaChunkSize/2)
+	if err := newBuilder().Image(data); err != nil {
+		t.Fatalf("Image returned error: %v", err)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 3 {
+		t.Fatalf("WebSocket messages count = %d, want 3", len(messages))
+	}
+
+	var received []byte
+	for _, msg := range messages[:2] {
+		chunk := msg.GetMediaChunk()
+		if chunk == nil {
+			t.Fatal("WebSocket message type = nil, want MediaChunk")
+		}
+		received = append(received, chunk.Data...)
+	}
+	if !messages[1].GetMediaChunk().Last {
+		t.Error("final chunk Last = false, want true")
+	}
+	if !bytes.Equal(received, data) {
+		t.Errorf("received %d bytes, want %d", len(received), len(data))
+	}
+
+	render := messages[2].GetRenderWidget()
+	if render == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+	if got, want := render.Widget.GetImage().MediaId, messages[0].GetMediaChunk().MediaId; got != want {
+		t.Errorf("MediaId = %v, want %v", got, want)
+	}
+
+	// A rerun with the same bytes references the media without resending it.
+	if err := newBuilder().Image(data); err != nil {
+		t.Fatalf("Image returned error: %v", err)
+	}
+	if got := len(mockWS.Messages()); got != 4 {
+		t.Errorf("WebSocket messages count after rerun = %d, want 4", got)
+	}
+}
+
+func TestImage_GoImage(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	img := stdimage.NewRGBA(stdimage.Rect(0, 0, 2, 2))
+	img.Set(0, 0, color.Black)
+	if err := builder.Image(img); err != nil {
+		t.Fatalf("Image returned error: %v", err)
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeImage, []int{0})
+	state := sess.State.GetImage(widgetID)
+	if state == nil {
+		t.Fatal("Image state not found")
+	}
+	if state.MimeType != "image/png" {
+		t.Errorf("MimeType = %v, want image/png", state.MimeType)
+	}
+	if state.MediaID == "" {
+		t.Error("MediaID is empty")
+	}
+}
+
+func TestImage_UnsupportedSource(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	if err := builder.Image(42); err == nil {
+		t.Error("Image with unsupported source returned nil error")
+	}
+	if got := len(mockWS.Messages()); got != 0 {
+		t.Errorf("WebSocket messages count = %d, want 0", got)
+	}
+}
@@ -0,0 +1,9 @@
+package options
+
+type AlertOptions struct {
+	Kind        string
+	Message     string
+	Title       string
+	Icon        string
+	Dismissible bool
+}
@@ -0,0 +1,8 @@
+package options
+
+type AudioOptions struct {
+	Caption  string
+	MimeType string
+	Autoplay bool
+	Loop     bool
+}
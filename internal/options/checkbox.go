@@ -5,4 +5,5 @@ type CheckboxOptions struct {
 	DefaultValue bool
 	Required     bool
 	Disabled     bool
+	QueryParam   string
 }
@@ -7,4 +7,5 @@ type CheckboxGroupOptions struct {
 	Required     bool
 	Disabled     bool
 	FormatFunc   func(string, int) string
+	QueryParam   string
 }
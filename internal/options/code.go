@@ -0,0 +1,9 @@
+package options
+
+type CodeOptions struct {
+	Code           string
+	Language       string
+	LineNumbers    bool
+	HighlightLines []int
+	Wrap           bool
+}
@@ -0,0 +1,8 @@
+package options
+
+type ColorPickerOptions struct {
+	Label        string
+	DefaultValue string
+	Required     bool
+	Disabled     bool
+}
@@ -12,4 +12,5 @@ type DateInputOptions struct {
 	MaxValue     *time.Time
 	MinValue     *time.Time
 	Location     *time.Location
+	QueryParam   string
 }
@@ -0,0 +1,15 @@
+package options
+
+import "time"
+
+type DateRangeInputOptions struct {
+	DateInputOptions
+	DefaultFromValue *time.Time
+	DefaultToValue   *time.Time
+	Presets          []DateRangeInputPreset
+}
+
+type DateRangeInputPreset struct {
+	Label string
+	Range func(today time.Time) (time.Time, time.Time)
+}
@@ -0,0 +1,14 @@
+package options
+
+import "time"
+
+type DateRangeSliderOptions struct {
+	Label        string
+	DefaultValue *[2]time.Time
+	MinValue     *time.Time
+	MaxValue     *time.Time
+	Step         int
+	Format       string
+	Disabled     bool
+	Location     *time.Location
+}
@@ -12,4 +12,5 @@ type DateTimeInputOptions struct {
 	MaxValue     *time.Time
 	MinValue     *time.Time
 	Location     *time.Location
+	QueryParam   string
 }
@@ -0,0 +1,8 @@
+package options
+
+type DownloadButtonOptions struct {
+	Label    string
+	Filename string
+	MimeType string
+	Disabled bool
+}
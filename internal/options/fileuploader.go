@@ -0,0 +1,10 @@
+package options
+
+type FileUploaderOptions struct {
+	Label    string
+	Accept   []string
+	MaxSize  *int64
+	Multiple bool
+	Required bool
+	Disabled bool
+}
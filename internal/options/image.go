@@ -0,0 +1,7 @@
+package options
+
+type ImageOptions struct {
+	Caption  string
+	Width    *int32
+	MimeType string
+}
@@ -0,0 +1,12 @@
+package options
+
+type IntInputOptions struct {
+	Label        string
+	Placeholder  string
+	DefaultValue *int64
+	Required     bool
+	Disabled     bool
+	MaxValue     *int64
+	MinValue     *int64
+	Step         *int64
+}
@@ -0,0 +1,5 @@
+package options
+
+type JSONOptions struct {
+	ExpandDepth *int32
+}
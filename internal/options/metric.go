@@ -0,0 +1,13 @@
+package options
+
+type MetricOptions struct {
+	Label       string
+	Value       float64
+	Delta       *float64
+	DeltaColor  string
+	DeltaFormat string
+	Help        string
+	Format      string
+	Currency    string
+	Precision   *int
+}
@@ -1,5 +1,7 @@
 package options
 
+import "context"
+
 type MultiSelectOptions struct {
 	Label        string
 	Options      []string
@@ -8,4 +10,7 @@ type MultiSelectOptions struct {
 	Required     bool
 	Disabled     bool
 	FormatFunc   func(string, int) string
+	SearchFunc   func(context.Context, string) ([]string, []string, error)
+	Creatable    bool
+	QueryParam   string
 }
@@ -0,0 +1,5 @@
+package options
+
+type NavigateOptions struct {
+	KeepState bool
+}
@@ -8,4 +8,7 @@ type NumberInputOptions struct {
 	Disabled     bool
 	MaxValue     *float64
 	MinValue     *float64
+	Step         *float64
+	Precision    *int
+	QueryParam   string
 }
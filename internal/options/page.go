@@ -0,0 +1,10 @@
+package options
+
+type PageOptions struct {
+	ID          string
+	Icon        string
+	Description string
+	Order       *int
+	Hidden      bool
+	GroupLabel  string
+}
@@ -7,4 +7,5 @@ type RadioOptions struct {
 	Required     bool
 	Disabled     bool
 	FormatFunc   func(string, int) string
+	QueryParam   string
 }
@@ -0,0 +1,11 @@
+package options
+
+type RangeSliderOptions struct {
+	Label        string
+	DefaultValue *[2]float64
+	MinValue     float64
+	MaxValue     float64
+	Step         float64
+	Format       string
+	Disabled     bool
+}
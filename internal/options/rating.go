@@ -0,0 +1,9 @@
+package options
+
+type RatingOptions struct {
+	Label        string
+	MaxValue     int
+	DefaultValue int
+	Required     bool
+	Disabled     bool
+}
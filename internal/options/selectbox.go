@@ -1,5 +1,7 @@
 package options
 
+import "context"
+
 type SelectboxOptions struct {
 	Label        string
 	Options      []string
@@ -8,4 +10,6 @@ type SelectboxOptions struct {
 	Required     bool
 	Disabled     bool
 	FormatFunc   func(string, int) string
+	SearchFunc   func(context.Context, string) ([]string, []string, error)
+	QueryParam   string
 }
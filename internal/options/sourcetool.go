@@ -0,0 +1,15 @@
+package options
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type SourcetoolOptions struct {
+	PingInterval   time.Duration
+	ReconnectDelay time.Duration
+	QueueSize      int
+	Logger         *zap.Logger
+	Location       *time.Location
+}
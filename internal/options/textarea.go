@@ -11,4 +11,5 @@ type TextAreaOptions struct {
 	MaxLines     *int32
 	MinLines     *int32
 	AutoResize   bool
+	QueryParam   string
 }
@@ -8,4 +8,5 @@ type TextInputOptions struct {
 	Disabled     bool
 	MaxLength    *int32
 	MinLength    *int32
+	QueryParam   string
 }
@@ -9,4 +9,5 @@ type TimeInputOptions struct {
 	Required     bool
 	Disabled     bool
 	Location     *time.Location
+	QueryParam   string
 }
@@ -0,0 +1,7 @@
+package options
+
+type ToggleOptions struct {
+	Label        string
+	DefaultValue bool
+	Disabled     bool
+}
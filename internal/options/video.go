@@ -0,0 +1,10 @@
+package options
+
+type VideoOptions struct {
+	Caption  string
+	Width    *int32
+	MimeType string
+	Autoplay bool
+	Loop     bool
+	Muted    bool
+}
@@ -28,6 +28,13 @@ type Page struct {
 	Route         string                 `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
 	Path          []int32                `protobuf:"varint,4,rep,packed,name=path,proto3" json:"path,omitempty"`
 	Groups        []string               `protobuf:"bytes,5,rep,name=groups,proto3" json:"groups,omitempty"`
+	Parameterized bool                   `protobuf:"varint,6,opt,name=parameterized,proto3" json:"parameterized,omitempty"`
+	Params        []string               `protobuf:"bytes,7,rep,name=params,proto3" json:"params,omitempty"`
+	Icon          string                 `protobuf:"bytes,8,opt,name=icon,proto3" json:"icon,omitempty"`
+	Description   string                 `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	Order         *int32                 `protobuf:"varint,10,opt,name=order,proto3,oneof" json:"order,omitempty"`
+	Hidden        bool                   `protobuf:"varint,11,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	GroupLabel    string                 `protobuf:"bytes,12,opt,name=group_label,json=groupLabel,proto3" json:"group_label,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -97,17 +104,76 @@ func (x *Page) GetGroups() []string {
 	return nil
 }
 
+func (x *Page) GetParameterized() bool {
+	if x != nil {
+		return x.Parameterized
+	}
+	return false
+}
+
+func (x *Page) GetParams() []string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *Page) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *Page) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Page) GetOrder() int32 {
+	if x != nil && x.Order != nil {
+		return *x.Order
+	}
+	return 0
+}
+
+func (x *Page) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *Page) GetGroupLabel() string {
+	if x != nil {
+		return x.GroupLabel
+	}
+	return ""
+}
+
 var File_page_v1_page_proto protoreflect.FileDescriptor
 
 const file_page_v1_page_proto_rawDesc = "" +
 	"\n" +
-	"\x12page/v1/page.proto\x12\apage.v1\"l\n" +
+	"\x12page/v1/page.proto\x12\apage.v1\"\xbe\x02\n" +
 	"\x04Page\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05route\x18\x03 \x01(\tR\x05route\x12\x12\n" +
 	"\x04path\x18\x04 \x03(\x05R\x04path\x12\x16\n" +
-	"\x06groups\x18\x05 \x03(\tR\x06groupsB\x98\x01\n" +
+	"\x06groups\x18\x05 \x03(\tR\x06groups\x12$\n" +
+	"\rparameterized\x18\x06 \x01(\bR\rparameterized\x12\x16\n" +
+	"\x06params\x18\a \x03(\tR\x06params\x12\x12\n" +
+	"\x04icon\x18\b \x01(\tR\x04icon\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\x12\x19\n" +
+	"\x05order\x18\n" +
+	" \x01(\x05H\x00R\x05order\x88\x01\x01\x12\x16\n" +
+	"\x06hidden\x18\v \x01(\bR\x06hidden\x12\x1f\n" +
+	"\vgroup_label\x18\f \x01(\tR\n" +
+	"groupLabelB\b\n" +
+	"\x06_orderB\x98\x01\n" +
 	"\vcom.page.v1B\tPageProtoP\x01ZAgithub.com/trysourcetool/sourcetool-go/internal/pb/page/v1;pagev1\xa2\x02\x03PXX\xaa\x02\aPage.V1\xca\x02\aPage\\V1\xe2\x02\x13Page\\V1\\GPBMetadata\xea\x02\bPage::V1b\x06proto3"
 
 var (
@@ -139,6 +205,7 @@ func file_page_v1_page_proto_init() {
 	if File_page_v1_page_proto != nil {
 		return
 	}
+	file_page_v1_page_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -87,6 +87,16 @@ type Message struct {
 	//	*Message_RerunPage
 	//	*Message_CloseSession
 	//	*Message_ScriptFinished
+	//	*Message_Toast
+	//	*Message_FileUploadStart
+	//	*Message_FileUploadChunk
+	//	*Message_DownloadStart
+	//	*Message_DownloadChunk
+	//	*Message_MediaChunk
+	//	*Message_SearchOptions
+	//	*Message_SearchOptionsResult
+	//	*Message_SetQueryParams
+	//	*Message_NavigatePage
 	Type          isMessage_Type `protobuf_oneof:"type"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -217,6 +227,96 @@ func (x *Message) GetScriptFinished() *ScriptFinished {
 	return nil
 }
 
+func (x *Message) GetToast() *Toast {
+	if x != nil {
+		if x, ok := x.Type.(*Message_Toast); ok {
+			return x.Toast
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetFileUploadStart() *FileUploadStart {
+	if x != nil {
+		if x, ok := x.Type.(*Message_FileUploadStart); ok {
+			return x.FileUploadStart
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetFileUploadChunk() *FileUploadChunk {
+	if x != nil {
+		if x, ok := x.Type.(*Message_FileUploadChunk); ok {
+			return x.FileUploadChunk
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetDownloadStart() *DownloadStart {
+	if x != nil {
+		if x, ok := x.Type.(*Message_DownloadStart); ok {
+			return x.DownloadStart
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetDownloadChunk() *DownloadChunk {
+	if x != nil {
+		if x, ok := x.Type.(*Message_DownloadChunk); ok {
+			return x.DownloadChunk
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetMediaChunk() *MediaChunk {
+	if x != nil {
+		if x, ok := x.Type.(*Message_MediaChunk); ok {
+			return x.MediaChunk
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetSearchOptions() *SearchOptions {
+	if x != nil {
+		if x, ok := x.Type.(*Message_SearchOptions); ok {
+			return x.SearchOptions
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetSearchOptionsResult() *SearchOptionsResult {
+	if x != nil {
+		if x, ok := x.Type.(*Message_SearchOptionsResult); ok {
+			return x.SearchOptionsResult
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetSetQueryParams() *SetQueryParams {
+	if x != nil {
+		if x, ok := x.Type.(*Message_SetQueryParams); ok {
+			return x.SetQueryParams
+		}
+	}
+	return nil
+}
+
+func (x *Message) GetNavigatePage() *NavigatePage {
+	if x != nil {
+		if x, ok := x.Type.(*Message_NavigatePage); ok {
+			return x.NavigatePage
+		}
+	}
+	return nil
+}
+
 type isMessage_Type interface {
 	isMessage_Type()
 }
@@ -257,6 +357,46 @@ type Message_ScriptFinished struct {
 	ScriptFinished *ScriptFinished `protobuf:"bytes,10,opt,name=script_finished,json=scriptFinished,proto3,oneof"`
 }
 
+type Message_Toast struct {
+	Toast *Toast `protobuf:"bytes,11,opt,name=toast,proto3,oneof"`
+}
+
+type Message_FileUploadStart struct {
+	FileUploadStart *FileUploadStart `protobuf:"bytes,12,opt,name=file_upload_start,json=fileUploadStart,proto3,oneof"`
+}
+
+type Message_FileUploadChunk struct {
+	FileUploadChunk *FileUploadChunk `protobuf:"bytes,13,opt,name=file_upload_chunk,json=fileUploadChunk,proto3,oneof"`
+}
+
+type Message_DownloadStart struct {
+	DownloadStart *DownloadStart `protobuf:"bytes,14,opt,name=download_start,json=downloadStart,proto3,oneof"`
+}
+
+type Message_DownloadChunk struct {
+	DownloadChunk *DownloadChunk `protobuf:"bytes,15,opt,name=download_chunk,json=downloadChunk,proto3,oneof"`
+}
+
+type Message_MediaChunk struct {
+	MediaChunk *MediaChunk `protobuf:"bytes,16,opt,name=media_chunk,json=mediaChunk,proto3,oneof"`
+}
+
+type Message_SearchOptions struct {
+	SearchOptions *SearchOptions `protobuf:"bytes,17,opt,name=search_options,json=searchOptions,proto3,oneof"`
+}
+
+type Message_SearchOptionsResult struct {
+	SearchOptionsResult *SearchOptionsResult `protobuf:"bytes,18,opt,name=search_options_result,json=searchOptionsResult,proto3,oneof"`
+}
+
+type Message_SetQueryParams struct {
+	SetQueryParams *SetQueryParams `protobuf:"bytes,19,opt,name=set_query_params,json=setQueryParams,proto3,oneof"`
+}
+
+type Message_NavigatePage struct {
+	NavigatePage *NavigatePage `protobuf:"bytes,20,opt,name=navigate_page,json=navigatePage,proto3,oneof"`
+}
+
 func (*Message_Exception) isMessage_Type() {}
 
 func (*Message_InitializeHost) isMessage_Type() {}
@@ -275,6 +415,26 @@ func (*Message_CloseSession) isMessage_Type() {}
 
 func (*Message_ScriptFinished) isMessage_Type() {}
 
+func (*Message_Toast) isMessage_Type() {}
+
+func (*Message_FileUploadStart) isMessage_Type() {}
+
+func (*Message_FileUploadChunk) isMessage_Type() {}
+
+func (*Message_DownloadStart) isMessage_Type() {}
+
+func (*Message_DownloadChunk) isMessage_Type() {}
+
+func (*Message_MediaChunk) isMessage_Type() {}
+
+func (*Message_SearchOptions) isMessage_Type() {}
+
+func (*Message_SearchOptionsResult) isMessage_Type() {}
+
+func (*Message_SetQueryParams) isMessage_Type() {}
+
+func (*Message_NavigatePage) isMessage_Type() {}
+
 type InitializeHost struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ApiKey        string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
@@ -391,6 +551,9 @@ type InitializeClient struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     *string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
 	PageId        string                 `protobuf:"bytes,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	UrlPath       string                 `protobuf:"bytes,3,opt,name=url_path,json=urlPath,proto3" json:"url_path,omitempty"`
+	QueryString   string                 `protobuf:"bytes,4,opt,name=query_string,json=queryString,proto3" json:"query_string,omitempty"`
+	UserGroups    []string               `protobuf:"bytes,5,rep,name=user_groups,json=userGroups,proto3" json:"user_groups,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -439,6 +602,27 @@ func (x *InitializeClient) GetPageId() string {
 	return ""
 }
 
+func (x *InitializeClient) GetUrlPath() string {
+	if x != nil {
+		return x.UrlPath
+	}
+	return ""
+}
+
+func (x *InitializeClient) GetQueryString() string {
+	if x != nil {
+		return x.QueryString
+	}
+	return ""
+}
+
+func (x *InitializeClient) GetUserGroups() []string {
+	if x != nil {
+		return x.UserGroups
+	}
+	return nil
+}
+
 type InitializeClientCompleted struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
@@ -556,6 +740,8 @@ type RerunPage struct {
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	PageId        string                 `protobuf:"bytes,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
 	States        []*v12.Widget          `protobuf:"bytes,3,rep,name=states,proto3" json:"states,omitempty"`
+	UrlPath       string                 `protobuf:"bytes,4,opt,name=url_path,json=urlPath,proto3" json:"url_path,omitempty"`
+	QueryString   string                 `protobuf:"bytes,5,opt,name=query_string,json=queryString,proto3" json:"query_string,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -611,6 +797,20 @@ func (x *RerunPage) GetStates() []*v12.Widget {
 	return nil
 }
 
+func (x *RerunPage) GetUrlPath() string {
+	if x != nil {
+		return x.UrlPath
+	}
+	return ""
+}
+
+func (x *RerunPage) GetQueryString() string {
+	if x != nil {
+		return x.QueryString
+	}
+	return ""
+}
+
 type CloseSession struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
@@ -707,63 +907,915 @@ func (x *ScriptFinished) GetStatus() ScriptFinished_Status {
 	return ScriptFinished_STATUS_UNSPECIFIED
 }
 
-var File_websocket_v1_message_proto protoreflect.FileDescriptor
+type Toast struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PageId        string                 `protobuf:"bytes,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_websocket_v1_message_proto_rawDesc = "" +
-	"\n" +
-	"\x1awebsocket/v1/message.proto\x12\fwebsocket.v1\x1a\x1cexception/v1/exception.proto\x1a\x12page/v1/page.proto\x1a\x16widget/v1/widget.proto\"\xcb\x05\n" +
-	"\aMessage\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
-	"\texception\x18\x02 \x01(\v2\x17.exception.v1.ExceptionH\x00R\texception\x12G\n" +
-	"\x0finitialize_host\x18\x03 \x01(\v2\x1c.websocket.v1.InitializeHostH\x00R\x0einitializeHost\x12c\n" +
-	"\x19initialize_host_completed\x18\x04 \x01(\v2%.websocket.v1.InitializeHostCompletedH\x00R\x17initializeHostCompleted\x12M\n" +
-	"\x11initialize_client\x18\x05 \x01(\v2\x1e.websocket.v1.InitializeClientH\x00R\x10initializeClient\x12i\n" +
-	"\x1binitialize_client_completed\x18\x06 \x01(\v2'.websocket.v1.InitializeClientCompletedH\x00R\x19initializeClientCompleted\x12A\n" +
-	"\rrender_widget\x18\a \x01(\v2\x1a.websocket.v1.RenderWidgetH\x00R\frenderWidget\x128\n" +
-	"\n" +
-	"rerun_page\x18\b \x01(\v2\x17.websocket.v1.RerunPageH\x00R\trerunPage\x12A\n" +
-	"\rclose_session\x18\t \x01(\v2\x1a.websocket.v1.CloseSessionH\x00R\fcloseSession\x12G\n" +
-	"\x0fscript_finished\x18\n" +
-	" \x01(\v2\x1c.websocket.v1.ScriptFinishedH\x00R\x0escriptFinishedB\x06\n" +
-	"\x04type\"\x8a\x01\n" +
-	"\x0eInitializeHost\x12\x17\n" +
-	"\aapi_key\x18\x01 \x01(\tR\x06apiKey\x12\x19\n" +
-	"\bsdk_name\x18\x02 \x01(\tR\asdkName\x12\x1f\n" +
-	"\vsdk_version\x18\x03 \x01(\tR\n" +
-	"sdkVersion\x12#\n" +
-	"\x05pages\x18\x04 \x03(\v2\r.page.v1.PageR\x05pages\"C\n" +
-	"\x17InitializeHostCompleted\x12(\n" +
-	"\x10host_instance_id\x18\x01 \x01(\tR\x0ehostInstanceId\"^\n" +
-	"\x10InitializeClient\x12\"\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12\x17\n" +
-	"\apage_id\x18\x02 \x01(\tR\x06pageIdB\r\n" +
-	"\v_session_id\":\n" +
-	"\x19InitializeClientCompleted\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"\x85\x01\n" +
-	"\fRenderWidget\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
-	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12\x12\n" +
-	"\x04path\x18\x03 \x03(\x05R\x04path\x12)\n" +
-	"\x06widget\x18\x04 \x01(\v2\x11.widget.v1.WidgetR\x06widget\"n\n" +
-	"\tRerunPage\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
-	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12)\n" +
-	"\x06states\x18\x03 \x03(\v2\x11.widget.v1.WidgetR\x06states\"-\n" +
-	"\fCloseSession\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"\xb6\x01\n" +
-	"\x0eScriptFinished\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\x12;\n" +
-	"\x06status\x18\x02 \x01(\x0e2#.websocket.v1.ScriptFinished.StatusR\x06status\"H\n" +
-	"\x06Status\x12\x16\n" +
-	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
-	"\x0eSTATUS_SUCCESS\x10\x01\x12\x12\n" +
-	"\x0eSTATUS_FAILURE\x10\x02B\xbe\x01\n" +
+func (x *Toast) Reset() {
+	*x = Toast{}
+	mi := &file_websocket_v1_message_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Toast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Toast) ProtoMessage() {}
+
+func (x *Toast) ProtoReflect() protoreflect.Message {
+	mi := &file_websocket_v1_message_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Toast.ProtoReflect.Descriptor instead.
+func (*Toast) Descriptor() ([]byte, []int) {
+	return file_websocket_v1_message_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Toast) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *Toast) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *Toast) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Toast) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Toast) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type FileUploadStart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	WidgetId      string                 `protobuf:"bytes,2,opt,name=widget_id,json=widgetId,proto3" json:"widget_id,omitempty"`
+	FileId        string                 `protobuf:"bytes,3,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	MimeType      string                 `protobuf:"bytes,5,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size          int64                  `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileUploadStart) Reset() {
+	*x = FileUploadStart{}
+	mi := &file_websocket_v1_message_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileUploadStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileUploadStart) ProtoMessage() {}
+
+func (x *FileUploadStart) ProtoReflect() protoreflect.Message {
+	mi := &file_websocket_v1_message_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileUploadStart.ProtoReflect.Descriptor instead.
+func (*FileUploadStart) Descriptor() ([]byte, []int) {
+	return file_websocket_v1_message_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FileUploadStart) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *FileUploadStart) GetWidgetId() string {
+	if x != nil {
+		return x.WidgetId
+	}
+	return ""
+}
+
+func (x *FileUploadStart) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *FileUploadStart) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileUploadStart) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *FileUploadStart) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type FileUploadChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	FileId        string                 `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	Offset        int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Last          bool                   `protobuf:"varint,5,opt,name=last,proto3" json:"last,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileUploadChunk) Reset() {
+	*x = FileUploadChunk{}
+	mi := &file_websocket_v1_message_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileUploadChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileUploadChunk) ProtoMessage() {}
+
+func (x *FileUploadChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_websocket_v1_message_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileUploadChunk.ProtoReflect.Descriptor instead.
+func (*FileUploadChunk) Descriptor() ([]byte, []int) {
+	return file_websocket_v1_message_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FileUploadChunk) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *FileUploadChunk) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *FileUploadChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *FileUploadChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FileUploadChunk) GetLast() bool {
+	if x != nil {
+		return x.Last
+	}
+	return false
+}
+
+type DownloadStart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	WidgetId      string                 `protobuf:"bytes,2,opt,name=widget_id,json=widgetId,proto3" json:"widget_id,omitempty"`
+	DownloadId    string                 `protobuf:"bytes,3,opt,name=download_id,json=downloadId,proto3" json:"download_id,omitempty"`
+	Filename      string                 `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	MimeType      string                 `protobuf:"bytes,5,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadStart) Reset() {
+	*x = DownloadStart{}
+	mi := &file_websocket_v1_message_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadStart) ProtoMessage() {}
+
+func (x *DownloadStart) ProtoReflect() protoreflect.Message {
+	mi := &file_websocket_v1_message_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadStart.ProtoReflect.Descriptor instead.
+func (*DownloadStart) Descriptor() ([]byte, []int) {
+	return file_websocket_v1_message_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DownloadStart) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DownloadStart) GetWidgetId() string {
+	if x != nil {
+		return x.WidgetId
+	}
+	return ""
+}
+
+func (x *DownloadStart) GetDownloadId() string {
+	if x != nil {
+		return x.DownloadId
+	}
+	return ""
+}
+
+func (x *DownloadStart) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *DownloadStart) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+type DownloadChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	DownloadId    string                 `protobuf:"bytes,2,opt,name=download_id,json=downloadId,proto3" json:"download_id,omitempty"`
+	Offset        int64                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data          []byte                 `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	Last          bool                   `protobuf:"varint,5,opt,name=last,proto3" json:"last,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadChunk) Reset() {
+	*x = DownloadChunk{}
+	mi := &file_websocket_v1_message_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadChunk) ProtoMessage() {}
+
+func (x *DownloadChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_websocket_v1_message_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadChunk.ProtoReflect.Descriptor instead.
+func (*DownloadChunk) Descriptor() ([]byte, []int) {
+	return file_websocket_v1_message_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DownloadChunk) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *DownloadChunk) GetDownloadId() string {
+	if x != nil {
+		return x.DownloadId
+	}
+	return ""
+}
+
+func (x *DownloadChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *DownloadChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DownloadChunk) GetLast() bool {
+	if x != nil {
+		return x.Last
+	}
+	return false
+}
+
+func (x *DownloadChunk) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type MediaChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	MediaId       string                 `protobuf:"bytes,2,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	Offset        int64                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Data          []byte                 `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	Last          bool                   `protobuf:"varint,7,opt,name=last,proto3" json:"last,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaChunk) Reset() {
+	*x = MediaChunk{}
+	mi := &file_websocket_v1_message_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaChunk) ProtoMessage() {}
+
+func (x *MediaChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_websocket_v1_message_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaChunk.ProtoReflect.Descriptor instead.
+func (*MediaChunk) Descriptor() ([]byte, []int) {
+	return file_websocket_v1_message_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MediaChunk) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *MediaChunk) GetMediaId() string {
+	if x != nil {
+		return x.MediaId
+	}
+	return ""
+}
+
+func (x *MediaChunk) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *MediaChunk) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *MediaChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *MediaChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *MediaChunk) GetLast() bool {
+	if x != nil {
+		return x.Last
+	}
+	return false
+}
+
+type SearchOptions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	WidgetId      string                 `protobuf:"bytes,2,opt,name=widget_id,json=widgetId,proto3" json:"widget_id,omitempty"`
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchOptions) Reset() {
+	*x = SearchOptions{}
+	mi := &file_websocket_v1_message_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOptions) ProtoMessage() {}
+
+func (x *SearchOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_websocket_v1_message_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOptions.ProtoReflect.Descriptor instead.
+func (*SearchOptions) Descriptor() ([]byte, []int) {
+	return file_websocket_v1_message_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SearchOptions) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SearchOptions) GetWidgetId() string {
+	if x != nil {
+		return x.WidgetId
+	}
+	return ""
+}
+
+func (x *SearchOptions) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type SearchOptionsResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	WidgetId      string                 `protobuf:"bytes,2,opt,name=widget_id,json=widgetId,proto3" json:"widget_id,omitempty"`
+	Query         string                 `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	Values        []string               `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`
+	Labels        []string               `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty"`
+	Error         string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchOptionsResult) Reset() {
+	*x = SearchOptionsResult{}
+	mi := &file_websocket_v1_message_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchOptionsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchOptionsResult) ProtoMessage() {}
+
+func (x *SearchOptionsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_websocket_v1_message_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchOptionsResult.ProtoReflect.Descriptor instead.
+func (*SearchOptionsResult) Descriptor() ([]byte, []int) {
+	return file_websocket_v1_message_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchOptionsResult) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SearchOptionsResult) GetWidgetId() string {
+	if x != nil {
+		return x.WidgetId
+	}
+	return ""
+}
+
+func (x *SearchOptionsResult) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchOptionsResult) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *SearchOptionsResult) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *SearchOptionsResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SetQueryParams struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PageId        string                 `protobuf:"bytes,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	QueryString   string                 `protobuf:"bytes,3,opt,name=query_string,json=queryString,proto3" json:"query_string,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQueryParams) Reset() {
+	*x = SetQueryParams{}
+	mi := &file_websocket_v1_message_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQueryParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQueryParams) ProtoMessage() {}
+
+func (x *SetQueryParams) ProtoReflect() protoreflect.Message {
+	mi := &file_websocket_v1_message_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQueryParams.ProtoReflect.Descriptor instead.
+func (*SetQueryParams) Descriptor() ([]byte, []int) {
+	return file_websocket_v1_message_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SetQueryParams) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SetQueryParams) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *SetQueryParams) GetQueryString() string {
+	if x != nil {
+		return x.QueryString
+	}
+	return ""
+}
+
+type NavigatePage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PageId        string                 `protobuf:"bytes,2,opt,name=page_id,json=pageId,proto3" json:"page_id,omitempty"`
+	UrlPath       string                 `protobuf:"bytes,3,opt,name=url_path,json=urlPath,proto3" json:"url_path,omitempty"`
+	KeepState     bool                   `protobuf:"varint,4,opt,name=keep_state,json=keepState,proto3" json:"keep_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NavigatePage) Reset() {
+	*x = NavigatePage{}
+	mi := &file_websocket_v1_message_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NavigatePage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NavigatePage) ProtoMessage() {}
+
+func (x *NavigatePage) ProtoReflect() protoreflect.Message {
+	mi := &file_websocket_v1_message_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NavigatePage.ProtoReflect.Descriptor instead.
+func (*NavigatePage) Descriptor() ([]byte, []int) {
+	return file_websocket_v1_message_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *NavigatePage) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *NavigatePage) GetPageId() string {
+	if x != nil {
+		return x.PageId
+	}
+	return ""
+}
+
+func (x *NavigatePage) GetUrlPath() string {
+	if x != nil {
+		return x.UrlPath
+	}
+	return ""
+}
+
+func (x *NavigatePage) GetKeepState() bool {
+	if x != nil {
+		return x.KeepState
+	}
+	return false
+}
+
+var File_websocket_v1_message_proto protoreflect.FileDescriptor
+
+const file_websocket_v1_message_proto_rawDesc = "" +
+	"\n" +
+	"\x1awebsocket/v1/message.proto\x12\fwebsocket.v1\x1a\x1cexception/v1/exception.proto\x1a\x12page/v1/page.proto\x1a\x16widget/v1/widget.proto\"\x87\v\n" +
+	"\aMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\texception\x18\x02 \x01(\v2\x17.exception.v1.ExceptionH\x00R\texception\x12G\n" +
+	"\x0finitialize_host\x18\x03 \x01(\v2\x1c.websocket.v1.InitializeHostH\x00R\x0einitializeHost\x12c\n" +
+	"\x19initialize_host_completed\x18\x04 \x01(\v2%.websocket.v1.InitializeHostCompletedH\x00R\x17initializeHostCompleted\x12M\n" +
+	"\x11initialize_client\x18\x05 \x01(\v2\x1e.websocket.v1.InitializeClientH\x00R\x10initializeClient\x12i\n" +
+	"\x1binitialize_client_completed\x18\x06 \x01(\v2'.websocket.v1.InitializeClientCompletedH\x00R\x19initializeClientCompleted\x12A\n" +
+	"\rrender_widget\x18\a \x01(\v2\x1a.websocket.v1.RenderWidgetH\x00R\frenderWidget\x128\n" +
+	"\n" +
+	"rerun_page\x18\b \x01(\v2\x17.websocket.v1.RerunPageH\x00R\trerunPage\x12A\n" +
+	"\rclose_session\x18\t \x01(\v2\x1a.websocket.v1.CloseSessionH\x00R\fcloseSession\x12G\n" +
+	"\x0fscript_finished\x18\n" +
+	" \x01(\v2\x1c.websocket.v1.ScriptFinishedH\x00R\x0escriptFinished\x12+\n" +
+	"\x05toast\x18\v \x01(\v2\x13.websocket.v1.ToastH\x00R\x05toast\x12K\n" +
+	"\x11file_upload_start\x18\f \x01(\v2\x1d.websocket.v1.FileUploadStartH\x00R\x0ffileUploadStart\x12K\n" +
+	"\x11file_upload_chunk\x18\r \x01(\v2\x1d.websocket.v1.FileUploadChunkH\x00R\x0ffileUploadChunk\x12D\n" +
+	"\x0edownload_start\x18\x0e \x01(\v2\x1b.websocket.v1.DownloadStartH\x00R\rdownloadStart\x12D\n" +
+	"\x0edownload_chunk\x18\x0f \x01(\v2\x1b.websocket.v1.DownloadChunkH\x00R\rdownloadChunk\x12;\n" +
+	"\vmedia_chunk\x18\x10 \x01(\v2\x18.websocket.v1.MediaChunkH\x00R\n" +
+	"mediaChunk\x12D\n" +
+	"\x0esearch_options\x18\x11 \x01(\v2\x1b.websocket.v1.SearchOptionsH\x00R\rsearchOptions\x12W\n" +
+	"\x15search_options_result\x18\x12 \x01(\v2!.websocket.v1.SearchOptionsResultH\x00R\x13searchOptionsResult\x12H\n" +
+	"\x10set_query_params\x18\x13 \x01(\v2\x1c.websocket.v1.SetQueryParamsH\x00R\x0esetQueryParams\x12A\n" +
+	"\rnavigate_page\x18\x14 \x01(\v2\x1a.websocket.v1.NavigatePageH\x00R\fnavigatePageB\x06\n" +
+	"\x04type\"\x8a\x01\n" +
+	"\x0eInitializeHost\x12\x17\n" +
+	"\aapi_key\x18\x01 \x01(\tR\x06apiKey\x12\x19\n" +
+	"\bsdk_name\x18\x02 \x01(\tR\asdkName\x12\x1f\n" +
+	"\vsdk_version\x18\x03 \x01(\tR\n" +
+	"sdkVersion\x12#\n" +
+	"\x05pages\x18\x04 \x03(\v2\r.page.v1.PageR\x05pages\"C\n" +
+	"\x17InitializeHostCompleted\x12(\n" +
+	"\x10host_instance_id\x18\x01 \x01(\tR\x0ehostInstanceId\"\xbd\x01\n" +
+	"\x10InitializeClient\x12\"\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12\x19\n" +
+	"\burl_path\x18\x03 \x01(\tR\aurlPath\x12!\n" +
+	"\fquery_string\x18\x04 \x01(\tR\vqueryString\x12\x1f\n" +
+	"\vuser_groups\x18\x05 \x03(\tR\n" +
+	"userGroupsB\r\n" +
+	"\v_session_id\":\n" +
+	"\x19InitializeClientCompleted\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\x85\x01\n" +
+	"\fRenderWidget\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12\x12\n" +
+	"\x04path\x18\x03 \x03(\x05R\x04path\x12)\n" +
+	"\x06widget\x18\x04 \x01(\v2\x11.widget.v1.WidgetR\x06widget\"\xac\x01\n" +
+	"\tRerunPage\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12)\n" +
+	"\x06states\x18\x03 \x03(\v2\x11.widget.v1.WidgetR\x06states\x12\x19\n" +
+	"\burl_path\x18\x04 \x01(\tR\aurlPath\x12!\n" +
+	"\fquery_string\x18\x05 \x01(\tR\vqueryString\"-\n" +
+	"\fCloseSession\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"\xb6\x01\n" +
+	"\x0eScriptFinished\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12;\n" +
+	"\x06status\x18\x02 \x01(\x0e2#.websocket.v1.ScriptFinished.StatusR\x06status\"H\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eSTATUS_SUCCESS\x10\x01\x12\x12\n" +
+	"\x0eSTATUS_FAILURE\x10\x02\"\x8e\x01\n" +
+	"\x05Toast\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x1f\n" +
+	"\vduration_ms\x18\x05 \x01(\x03R\n" +
+	"durationMs\"\xab\x01\n" +
+	"\x0fFileUploadStart\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\twidget_id\x18\x02 \x01(\tR\bwidgetId\x12\x17\n" +
+	"\afile_id\x18\x03 \x01(\tR\x06fileId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1b\n" +
+	"\tmime_type\x18\x05 \x01(\tR\bmimeType\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\"\x89\x01\n" +
+	"\x0fFileUploadChunk\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\x12\x12\n" +
+	"\x04last\x18\x05 \x01(\bR\x04last\"\xa5\x01\n" +
+	"\rDownloadStart\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\twidget_id\x18\x02 \x01(\tR\bwidgetId\x12\x1f\n" +
+	"\vdownload_id\x18\x03 \x01(\tR\n" +
+	"downloadId\x12\x1a\n" +
+	"\bfilename\x18\x04 \x01(\tR\bfilename\x12\x1b\n" +
+	"\tmime_type\x18\x05 \x01(\tR\bmimeType\"\xa5\x01\n" +
+	"\rDownloadChunk\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1f\n" +
+	"\vdownload_id\x18\x02 \x01(\tR\n" +
+	"downloadId\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\x12\x12\n" +
+	"\x04last\x18\x05 \x01(\bR\x04last\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\xb7\x01\n" +
+	"\n" +
+	"MediaChunk\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x19\n" +
+	"\bmedia_id\x18\x02 \x01(\tR\amediaId\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04data\x18\x06 \x01(\fR\x04data\x12\x12\n" +
+	"\x04last\x18\a \x01(\bR\x04last\"a\n" +
+	"\rSearchOptions\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\twidget_id\x18\x02 \x01(\tR\bwidgetId\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\"\xad\x01\n" +
+	"\x13SearchOptionsResult\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\twidget_id\x18\x02 \x01(\tR\bwidgetId\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12\x16\n" +
+	"\x06values\x18\x04 \x03(\tR\x06values\x12\x16\n" +
+	"\x06labels\x18\x05 \x03(\tR\x06labels\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"k\n" +
+	"\x0eSetQueryParams\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12!\n" +
+	"\fquery_string\x18\x03 \x01(\tR\vqueryString\"\x80\x01\n" +
+	"\fNavigatePage\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\apage_id\x18\x02 \x01(\tR\x06pageId\x12\x19\n" +
+	"\burl_path\x18\x03 \x01(\tR\aurlPath\x12\x1d\n" +
+	"\n" +
+	"keep_state\x18\x04 \x01(\bR\tkeepStateB\xbe\x01\n" +
 	"\x10com.websocket.v1B\fMessageProtoP\x01ZKgithub.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1;websocketv1\xa2\x02\x03WXX\xaa\x02\fWebsocket.V1\xca\x02\fWebsocket\\V1\xe2\x02\x18Websocket\\V1\\GPBMetadata\xea\x02\rWebsocket::V1b\x06proto3"
 
 var (
@@ -779,7 +1831,7 @@ func file_websocket_v1_message_proto_rawDescGZIP() []byte {
 }
 
 var file_websocket_v1_message_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_websocket_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_websocket_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_websocket_v1_message_proto_goTypes = []any{
 	(ScriptFinished_Status)(0),        // 0: websocket.v1.ScriptFinished.Status
 	(*Message)(nil),                   // 1: websocket.v1.Message
@@ -791,12 +1843,22 @@ var file_websocket_v1_message_proto_goTypes = []any{
 	(*RerunPage)(nil),                 // 7: websocket.v1.RerunPage
 	(*CloseSession)(nil),              // 8: websocket.v1.CloseSession
 	(*ScriptFinished)(nil),            // 9: websocket.v1.ScriptFinished
-	(*v1.Exception)(nil),              // 10: exception.v1.Exception
-	(*v11.Page)(nil),                  // 11: page.v1.Page
-	(*v12.Widget)(nil),                // 12: widget.v1.Widget
+	(*Toast)(nil),                     // 10: websocket.v1.Toast
+	(*FileUploadStart)(nil),           // 11: websocket.v1.FileUploadStart
+	(*FileUploadChunk)(nil),           // 12: websocket.v1.FileUploadChunk
+	(*DownloadStart)(nil),             // 13: websocket.v1.DownloadStart
+	(*DownloadChunk)(nil),             // 14: websocket.v1.DownloadChunk
+	(*MediaChunk)(nil),                // 15: websocket.v1.MediaChunk
+	(*SearchOptions)(nil),             // 16: websocket.v1.SearchOptions
+	(*SearchOptionsResult)(nil),       // 17: websocket.v1.SearchOptionsResult
+	(*SetQueryParams)(nil),            // 18: websocket.v1.SetQueryParams
+	(*NavigatePage)(nil),              // 19: websocket.v1.NavigatePage
+	(*v1.Exception)(nil),              // 20: exception.v1.Exception
+	(*v11.Page)(nil),                  // 21: page.v1.Page
+	(*v12.Widget)(nil),                // 22: widget.v1.Widget
 }
 var file_websocket_v1_message_proto_depIdxs = []int32{
-	10, // 0: websocket.v1.Message.exception:type_name -> exception.v1.Exception
+	20, // 0: websocket.v1.Message.exception:type_name -> exception.v1.Exception
 	2,  // 1: websocket.v1.Message.initialize_host:type_name -> websocket.v1.InitializeHost
 	3,  // 2: websocket.v1.Message.initialize_host_completed:type_name -> websocket.v1.InitializeHostCompleted
 	4,  // 3: websocket.v1.Message.initialize_client:type_name -> websocket.v1.InitializeClient
@@ -805,15 +1867,25 @@ var file_websocket_v1_message_proto_depIdxs = []int32{
 	7,  // 6: websocket.v1.Message.rerun_page:type_name -> websocket.v1.RerunPage
 	8,  // 7: websocket.v1.Message.close_session:type_name -> websocket.v1.CloseSession
 	9,  // 8: websocket.v1.Message.script_finished:type_name -> websocket.v1.ScriptFinished
-	11, // 9: websocket.v1.InitializeHost.pages:type_name -> page.v1.Page
-	12, // 10: websocket.v1.RenderWidget.widget:type_name -> widget.v1.Widget
-	12, // 11: websocket.v1.RerunPage.states:type_name -> widget.v1.Widget
-	0,  // 12: websocket.v1.ScriptFinished.status:type_name -> websocket.v1.ScriptFinished.Status
-	13, // [13:13] is the sub-list for method output_type
-	13, // [13:13] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	10, // 9: websocket.v1.Message.toast:type_name -> websocket.v1.Toast
+	11, // 10: websocket.v1.Message.file_upload_start:type_name -> websocket.v1.FileUploadStart
+	12, // 11: websocket.v1.Message.file_upload_chunk:type_name -> websocket.v1.FileUploadChunk
+	13, // 12: websocket.v1.Message.download_start:type_name -> websocket.v1.DownloadStart
+	14, // 13: websocket.v1.Message.download_chunk:type_name -> websocket.v1.DownloadChunk
+	15, // 14: websocket.v1.Message.media_chunk:type_name -> websocket.v1.MediaChunk
+	16, // 15: websocket.v1.Message.search_options:type_name -> websocket.v1.SearchOptions
+	17, // 16: websocket.v1.Message.search_options_result:type_name -> websocket.v1.SearchOptionsResult
+	18, // 17: websocket.v1.Message.set_query_params:type_name -> websocket.v1.SetQueryParams
+	19, // 18: websocket.v1.Message.navigate_page:type_name -> websocket.v1.NavigatePage
+	21, // 19: websocket.v1.InitializeHost.pages:type_name -> page.v1.Page
+	22, // 20: websocket.v1.RenderWidget.widget:type_name -> widget.v1.Widget
+	22, // 21: websocket.v1.RerunPage.states:type_name -> widget.v1.Widget
+	0,  // 22: websocket.v1.ScriptFinished.status:type_name -> websocket.v1.ScriptFinished.Status
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_websocket_v1_message_proto_init() }
@@ -831,6 +1903,16 @@ func file_websocket_v1_message_proto_init() {
 		(*Message_RerunPage)(nil),
 		(*Message_CloseSession)(nil),
 		(*Message_ScriptFinished)(nil),
+		(*Message_Toast)(nil),
+		(*Message_FileUploadStart)(nil),
+		(*Message_FileUploadChunk)(nil),
+		(*Message_DownloadStart)(nil),
+		(*Message_DownloadChunk)(nil),
+		(*Message_MediaChunk)(nil),
+		(*Message_SearchOptions)(nil),
+		(*Message_SearchOptionsResult)(nil),
+		(*Message_SetQueryParams)(nil),
+		(*Message_NavigatePage)(nil),
 	}
 	file_websocket_v1_message_proto_msgTypes[3].OneofWrappers = []any{}
 	type x struct{}
@@ -839,7 +1921,7 @@ func file_websocket_v1_message_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_websocket_v1_message_proto_rawDesc), len(file_websocket_v1_message_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   9,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
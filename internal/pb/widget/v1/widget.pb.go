@@ -21,6 +21,174 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Alert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Icon          string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Dismissible   bool                   `protobuf:"varint,5,opt,name=dismissible,proto3" json:"dismissible,omitempty"`
+	Dismissed     bool                   `protobuf:"varint,6,opt,name=dismissed,proto3" json:"dismissed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Alert) Reset() {
+	*x = Alert{}
+	mi := &file_widget_v1_widget_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Alert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Alert) ProtoMessage() {}
+
+func (x *Alert) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Alert.ProtoReflect.Descriptor instead.
+func (*Alert) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Alert) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Alert) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Alert) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Alert) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *Alert) GetDismissible() bool {
+	if x != nil {
+		return x.Dismissible
+	}
+	return false
+}
+
+func (x *Alert) GetDismissed() bool {
+	if x != nil {
+		return x.Dismissed
+	}
+	return false
+}
+
+type Audio struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Src           string                 `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	MediaId       string                 `protobuf:"bytes,2,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Caption       string                 `protobuf:"bytes,4,opt,name=caption,proto3" json:"caption,omitempty"`
+	Autoplay      bool                   `protobuf:"varint,5,opt,name=autoplay,proto3" json:"autoplay,omitempty"`
+	Loop          bool                   `protobuf:"varint,6,opt,name=loop,proto3" json:"loop,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Audio) Reset() {
+	*x = Audio{}
+	mi := &file_widget_v1_widget_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Audio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Audio) ProtoMessage() {}
+
+func (x *Audio) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Audio.ProtoReflect.Descriptor instead.
+func (*Audio) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Audio) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *Audio) GetMediaId() string {
+	if x != nil {
+		return x.MediaId
+	}
+	return ""
+}
+
+func (x *Audio) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *Audio) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+func (x *Audio) GetAutoplay() bool {
+	if x != nil {
+		return x.Autoplay
+	}
+	return false
+}
+
+func (x *Audio) GetLoop() bool {
+	if x != nil {
+		return x.Loop
+	}
+	return false
+}
+
 type Button struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Value         bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
@@ -32,7 +200,7 @@ type Button struct {
 
 func (x *Button) Reset() {
 	*x = Button{}
-	mi := &file_widget_v1_widget_proto_msgTypes[0]
+	mi := &file_widget_v1_widget_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -44,7 +212,7 @@ func (x *Button) String() string {
 func (*Button) ProtoMessage() {}
 
 func (x *Button) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[0]
+	mi := &file_widget_v1_widget_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,7 +225,7 @@ func (x *Button) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Button.ProtoReflect.Descriptor instead.
 func (*Button) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{0}
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Button) GetValue() bool {
@@ -94,7 +262,7 @@ type Checkbox struct {
 
 func (x *Checkbox) Reset() {
 	*x = Checkbox{}
-	mi := &file_widget_v1_widget_proto_msgTypes[1]
+	mi := &file_widget_v1_widget_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -106,7 +274,7 @@ func (x *Checkbox) String() string {
 func (*Checkbox) ProtoMessage() {}
 
 func (x *Checkbox) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[1]
+	mi := &file_widget_v1_widget_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -119,7 +287,7 @@ func (x *Checkbox) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Checkbox.ProtoReflect.Descriptor instead.
 func (*Checkbox) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{1}
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Checkbox) GetValue() bool {
@@ -171,7 +339,7 @@ type CheckboxGroup struct {
 
 func (x *CheckboxGroup) Reset() {
 	*x = CheckboxGroup{}
-	mi := &file_widget_v1_widget_proto_msgTypes[2]
+	mi := &file_widget_v1_widget_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -183,7 +351,7 @@ func (x *CheckboxGroup) String() string {
 func (*CheckboxGroup) ProtoMessage() {}
 
 func (x *CheckboxGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[2]
+	mi := &file_widget_v1_widget_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -196,7 +364,7 @@ func (x *CheckboxGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckboxGroup.ProtoReflect.Descriptor instead.
 func (*CheckboxGroup) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{2}
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CheckboxGroup) GetValue() []int32 {
@@ -241,28 +409,32 @@ func (x *CheckboxGroup) GetDisabled() bool {
 	return false
 }
 
-type ColumnItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Weight        float64                `protobuf:"fixed64,1,opt,name=weight,proto3" json:"weight,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Code struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Language       string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	LineNumbers    bool                   `protobuf:"varint,3,opt,name=line_numbers,json=lineNumbers,proto3" json:"line_numbers,omitempty"`
+	HighlightLines []int32                `protobuf:"varint,4,rep,packed,name=highlight_lines,json=highlightLines,proto3" json:"highlight_lines,omitempty"`
+	Wrap           bool                   `protobuf:"varint,5,opt,name=wrap,proto3" json:"wrap,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ColumnItem) Reset() {
-	*x = ColumnItem{}
-	mi := &file_widget_v1_widget_proto_msgTypes[3]
+func (x *Code) Reset() {
+	*x = Code{}
+	mi := &file_widget_v1_widget_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ColumnItem) String() string {
+func (x *Code) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ColumnItem) ProtoMessage() {}
+func (*Code) ProtoMessage() {}
 
-func (x *ColumnItem) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[3]
+func (x *Code) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -273,92 +445,72 @@ func (x *ColumnItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ColumnItem.ProtoReflect.Descriptor instead.
-func (*ColumnItem) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Code.ProtoReflect.Descriptor instead.
+func (*Code) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ColumnItem) GetWeight() float64 {
+func (x *Code) GetCode() string {
 	if x != nil {
-		return x.Weight
+		return x.Code
 	}
-	return 0
-}
-
-type Columns struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Columns       int32                  `protobuf:"varint,1,opt,name=columns,proto3" json:"columns,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Columns) Reset() {
-	*x = Columns{}
-	mi := &file_widget_v1_widget_proto_msgTypes[4]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *Columns) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Code) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
 }
 
-func (*Columns) ProtoMessage() {}
-
-func (x *Columns) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[4]
+func (x *Code) GetLineNumbers() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LineNumbers
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use Columns.ProtoReflect.Descriptor instead.
-func (*Columns) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{4}
+func (x *Code) GetHighlightLines() []int32 {
+	if x != nil {
+		return x.HighlightLines
+	}
+	return nil
 }
 
-func (x *Columns) GetColumns() int32 {
+func (x *Code) GetWrap() bool {
 	if x != nil {
-		return x.Columns
+		return x.Wrap
 	}
-	return 0
+	return false
 }
 
-type DateInput struct {
+type ColorPicker struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         *string                `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
-	DefaultValue  *string                `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
-	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
-	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	Format        string                 `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`
-	MaxValue      string                 `protobuf:"bytes,8,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
-	MinValue      string                 `protobuf:"bytes,9,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	DefaultValue  string                 `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DateInput) Reset() {
-	*x = DateInput{}
-	mi := &file_widget_v1_widget_proto_msgTypes[5]
+func (x *ColorPicker) Reset() {
+	*x = ColorPicker{}
+	mi := &file_widget_v1_widget_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateInput) String() string {
+func (x *ColorPicker) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DateInput) ProtoMessage() {}
+func (*ColorPicker) ProtoMessage() {}
 
-func (x *DateInput) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[5]
+func (x *ColorPicker) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -369,75 +521,135 @@ func (x *DateInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DateInput.ProtoReflect.Descriptor instead.
-func (*DateInput) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ColorPicker.ProtoReflect.Descriptor instead.
+func (*ColorPicker) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DateInput) GetValue() string {
-	if x != nil && x.Value != nil {
-		return *x.Value
+func (x *ColorPicker) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
 	return ""
 }
 
-func (x *DateInput) GetLabel() string {
+func (x *ColorPicker) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *DateInput) GetPlaceholder() string {
+func (x *ColorPicker) GetDefaultValue() string {
 	if x != nil {
-		return x.Placeholder
-	}
-	return ""
-}
-
-func (x *DateInput) GetDefaultValue() string {
-	if x != nil && x.DefaultValue != nil {
-		return *x.DefaultValue
+		return x.DefaultValue
 	}
 	return ""
 }
 
-func (x *DateInput) GetRequired() bool {
+func (x *ColorPicker) GetRequired() bool {
 	if x != nil {
 		return x.Required
 	}
 	return false
 }
 
-func (x *DateInput) GetDisabled() bool {
+func (x *ColorPicker) GetDisabled() bool {
 	if x != nil {
 		return x.Disabled
 	}
 	return false
 }
 
-func (x *DateInput) GetFormat() string {
-	if x != nil {
-		return x.Format
-	}
-	return ""
+type ColumnItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weight        float64                `protobuf:"fixed64,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DateInput) GetMaxValue() string {
-	if x != nil {
-		return x.MaxValue
-	}
-	return ""
+func (x *ColumnItem) Reset() {
+	*x = ColumnItem{}
+	mi := &file_widget_v1_widget_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateInput) GetMinValue() string {
+func (x *ColumnItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColumnItem) ProtoMessage() {}
+
+func (x *ColumnItem) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[7]
 	if x != nil {
-		return x.MinValue
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type DateTimeInput struct {
+// Deprecated: Use ColumnItem.ProtoReflect.Descriptor instead.
+func (*ColumnItem) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ColumnItem) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type Columns struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Columns       int32                  `protobuf:"varint,1,opt,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Columns) Reset() {
+	*x = Columns{}
+	mi := &file_widget_v1_widget_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Columns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Columns) ProtoMessage() {}
+
+func (x *Columns) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Columns.ProtoReflect.Descriptor instead.
+func (*Columns) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Columns) GetColumns() int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+type DateInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Value         *string                `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
 	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
@@ -452,21 +664,21 @@ type DateTimeInput struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DateTimeInput) Reset() {
-	*x = DateTimeInput{}
-	mi := &file_widget_v1_widget_proto_msgTypes[6]
+func (x *DateInput) Reset() {
+	*x = DateInput{}
+	mi := &file_widget_v1_widget_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DateTimeInput) String() string {
+func (x *DateInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DateTimeInput) ProtoMessage() {}
+func (*DateInput) ProtoMessage() {}
 
-func (x *DateTimeInput) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[6]
+func (x *DateInput) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -477,99 +689,1521 @@ func (x *DateTimeInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DateTimeInput.ProtoReflect.Descriptor instead.
-func (*DateTimeInput) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use DateInput.ProtoReflect.Descriptor instead.
+func (*DateInput) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DateTimeInput) GetValue() string {
+func (x *DateInput) GetValue() string {
 	if x != nil && x.Value != nil {
 		return *x.Value
 	}
 	return ""
 }
 
-func (x *DateTimeInput) GetLabel() string {
+func (x *DateInput) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *DateTimeInput) GetPlaceholder() string {
+func (x *DateInput) GetPlaceholder() string {
 	if x != nil {
 		return x.Placeholder
 	}
 	return ""
 }
 
-func (x *DateTimeInput) GetDefaultValue() string {
+func (x *DateInput) GetDefaultValue() string {
 	if x != nil && x.DefaultValue != nil {
 		return *x.DefaultValue
 	}
 	return ""
 }
 
-func (x *DateTimeInput) GetRequired() bool {
+func (x *DateInput) GetRequired() bool {
 	if x != nil {
 		return x.Required
 	}
 	return false
 }
 
-func (x *DateTimeInput) GetDisabled() bool {
+func (x *DateInput) GetDisabled() bool {
 	if x != nil {
 		return x.Disabled
 	}
 	return false
 }
 
-func (x *DateTimeInput) GetFormat() string {
+func (x *DateInput) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DateInput) GetMaxValue() string {
+	if x != nil {
+		return x.MaxValue
+	}
+	return ""
+}
+
+func (x *DateInput) GetMinValue() string {
+	if x != nil {
+		return x.MinValue
+	}
+	return ""
+}
+
+type DateRangeInput struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	FromValue        *string                 `protobuf:"bytes,1,opt,name=from_value,json=fromValue,proto3,oneof" json:"from_value,omitempty"`
+	ToValue          *string                 `protobuf:"bytes,2,opt,name=to_value,json=toValue,proto3,oneof" json:"to_value,omitempty"`
+	Label            string                  `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Placeholder      string                  `protobuf:"bytes,4,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	DefaultFromValue *string                 `protobuf:"bytes,5,opt,name=default_from_value,json=defaultFromValue,proto3,oneof" json:"default_from_value,omitempty"`
+	DefaultToValue   *string                 `protobuf:"bytes,6,opt,name=default_to_value,json=defaultToValue,proto3,oneof" json:"default_to_value,omitempty"`
+	Required         bool                    `protobuf:"varint,7,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled         bool                    `protobuf:"varint,8,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Format           string                  `protobuf:"bytes,9,opt,name=format,proto3" json:"format,omitempty"`
+	MaxValue         string                  `protobuf:"bytes,10,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	MinValue         string                  `protobuf:"bytes,11,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	Presets          []*DateRangeInputPreset `protobuf:"bytes,12,rep,name=presets,proto3" json:"presets,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DateRangeInput) Reset() {
+	*x = DateRangeInput{}
+	mi := &file_widget_v1_widget_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateRangeInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRangeInput) ProtoMessage() {}
+
+func (x *DateRangeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateRangeInput.ProtoReflect.Descriptor instead.
+func (*DateRangeInput) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DateRangeInput) GetFromValue() string {
+	if x != nil && x.FromValue != nil {
+		return *x.FromValue
+	}
+	return ""
+}
+
+func (x *DateRangeInput) GetToValue() string {
+	if x != nil && x.ToValue != nil {
+		return *x.ToValue
+	}
+	return ""
+}
+
+func (x *DateRangeInput) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *DateRangeInput) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *DateRangeInput) GetDefaultFromValue() string {
+	if x != nil && x.DefaultFromValue != nil {
+		return *x.DefaultFromValue
+	}
+	return ""
+}
+
+func (x *DateRangeInput) GetDefaultToValue() string {
+	if x != nil && x.DefaultToValue != nil {
+		return *x.DefaultToValue
+	}
+	return ""
+}
+
+func (x *DateRangeInput) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *DateRangeInput) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *DateRangeInput) GetFormat() string {
 	if x != nil {
 		return x.Format
 	}
 	return ""
 }
 
-func (x *DateTimeInput) GetMaxValue() string {
-	if x != nil {
-		return x.MaxValue
+func (x *DateRangeInput) GetMaxValue() string {
+	if x != nil {
+		return x.MaxValue
+	}
+	return ""
+}
+
+func (x *DateRangeInput) GetMinValue() string {
+	if x != nil {
+		return x.MinValue
+	}
+	return ""
+}
+
+func (x *DateRangeInput) GetPresets() []*DateRangeInputPreset {
+	if x != nil {
+		return x.Presets
+	}
+	return nil
+}
+
+type DateRangeInputPreset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateRangeInputPreset) Reset() {
+	*x = DateRangeInputPreset{}
+	mi := &file_widget_v1_widget_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateRangeInputPreset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRangeInputPreset) ProtoMessage() {}
+
+func (x *DateRangeInputPreset) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateRangeInputPreset.ProtoReflect.Descriptor instead.
+func (*DateRangeInputPreset) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DateRangeInputPreset) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *DateRangeInputPreset) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *DateRangeInputPreset) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type DateRangeSlider struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LowValue         string                 `protobuf:"bytes,1,opt,name=low_value,json=lowValue,proto3" json:"low_value,omitempty"`
+	HighValue        string                 `protobuf:"bytes,2,opt,name=high_value,json=highValue,proto3" json:"high_value,omitempty"`
+	Label            string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	DefaultLowValue  string                 `protobuf:"bytes,4,opt,name=default_low_value,json=defaultLowValue,proto3" json:"default_low_value,omitempty"`
+	DefaultHighValue string                 `protobuf:"bytes,5,opt,name=default_high_value,json=defaultHighValue,proto3" json:"default_high_value,omitempty"`
+	MinValue         string                 `protobuf:"bytes,6,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue         string                 `protobuf:"bytes,7,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	Step             int32                  `protobuf:"varint,8,opt,name=step,proto3" json:"step,omitempty"`
+	Format           string                 `protobuf:"bytes,9,opt,name=format,proto3" json:"format,omitempty"`
+	Disabled         bool                   `protobuf:"varint,10,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DateRangeSlider) Reset() {
+	*x = DateRangeSlider{}
+	mi := &file_widget_v1_widget_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateRangeSlider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRangeSlider) ProtoMessage() {}
+
+func (x *DateRangeSlider) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateRangeSlider.ProtoReflect.Descriptor instead.
+func (*DateRangeSlider) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DateRangeSlider) GetLowValue() string {
+	if x != nil {
+		return x.LowValue
+	}
+	return ""
+}
+
+func (x *DateRangeSlider) GetHighValue() string {
+	if x != nil {
+		return x.HighValue
+	}
+	return ""
+}
+
+func (x *DateRangeSlider) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *DateRangeSlider) GetDefaultLowValue() string {
+	if x != nil {
+		return x.DefaultLowValue
+	}
+	return ""
+}
+
+func (x *DateRangeSlider) GetDefaultHighValue() string {
+	if x != nil {
+		return x.DefaultHighValue
+	}
+	return ""
+}
+
+func (x *DateRangeSlider) GetMinValue() string {
+	if x != nil {
+		return x.MinValue
+	}
+	return ""
+}
+
+func (x *DateRangeSlider) GetMaxValue() string {
+	if x != nil {
+		return x.MaxValue
+	}
+	return ""
+}
+
+func (x *DateRangeSlider) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *DateRangeSlider) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DateRangeSlider) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type DateTimeInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *string                `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	DefaultValue  *string                `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Format        string                 `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`
+	MaxValue      string                 `protobuf:"bytes,8,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	MinValue      string                 `protobuf:"bytes,9,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateTimeInput) Reset() {
+	*x = DateTimeInput{}
+	mi := &file_widget_v1_widget_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateTimeInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateTimeInput) ProtoMessage() {}
+
+func (x *DateTimeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateTimeInput.ProtoReflect.Descriptor instead.
+func (*DateTimeInput) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DateTimeInput) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *DateTimeInput) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *DateTimeInput) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *DateTimeInput) GetDefaultValue() string {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return ""
+}
+
+func (x *DateTimeInput) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *DateTimeInput) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *DateTimeInput) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *DateTimeInput) GetMaxValue() string {
+	if x != nil {
+		return x.MaxValue
+	}
+	return ""
+}
+
+func (x *DateTimeInput) GetMinValue() string {
+	if x != nil {
+		return x.MinValue
+	}
+	return ""
+}
+
+type DownloadButton struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	MimeType      string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Disabled      bool                   `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadButton) Reset() {
+	*x = DownloadButton{}
+	mi := &file_widget_v1_widget_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadButton) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadButton) ProtoMessage() {}
+
+func (x *DownloadButton) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadButton.ProtoReflect.Descriptor instead.
+func (*DownloadButton) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DownloadButton) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+func (x *DownloadButton) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *DownloadButton) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *DownloadButton) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *DownloadButton) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type FileUploader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         []*UploadedFile        `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Accept        []string               `protobuf:"bytes,3,rep,name=accept,proto3" json:"accept,omitempty"`
+	MaxSize       *int64                 `protobuf:"varint,4,opt,name=max_size,json=maxSize,proto3,oneof" json:"max_size,omitempty"`
+	Multiple      bool                   `protobuf:"varint,5,opt,name=multiple,proto3" json:"multiple,omitempty"`
+	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileUploader) Reset() {
+	*x = FileUploader{}
+	mi := &file_widget_v1_widget_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileUploader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileUploader) ProtoMessage() {}
+
+func (x *FileUploader) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileUploader.ProtoReflect.Descriptor instead.
+func (*FileUploader) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FileUploader) GetValue() []*UploadedFile {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *FileUploader) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *FileUploader) GetAccept() []string {
+	if x != nil {
+		return x.Accept
+	}
+	return nil
+}
+
+func (x *FileUploader) GetMaxSize() int64 {
+	if x != nil && x.MaxSize != nil {
+		return *x.MaxSize
+	}
+	return 0
+}
+
+func (x *FileUploader) GetMultiple() bool {
+	if x != nil {
+		return x.Multiple
+	}
+	return false
+}
+
+func (x *FileUploader) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *FileUploader) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type Form struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Value          bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	ButtonLabel    string                 `protobuf:"bytes,2,opt,name=button_label,json=buttonLabel,proto3" json:"button_label,omitempty"`
+	ButtonDisabled bool                   `protobuf:"varint,3,opt,name=button_disabled,json=buttonDisabled,proto3" json:"button_disabled,omitempty"`
+	ClearOnSubmit  bool                   `protobuf:"varint,4,opt,name=clear_on_submit,json=clearOnSubmit,proto3" json:"clear_on_submit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Form) Reset() {
+	*x = Form{}
+	mi := &file_widget_v1_widget_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Form) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Form) ProtoMessage() {}
+
+func (x *Form) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Form.ProtoReflect.Descriptor instead.
+func (*Form) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Form) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+func (x *Form) GetButtonLabel() string {
+	if x != nil {
+		return x.ButtonLabel
+	}
+	return ""
+}
+
+func (x *Form) GetButtonDisabled() bool {
+	if x != nil {
+		return x.ButtonDisabled
+	}
+	return false
+}
+
+func (x *Form) GetClearOnSubmit() bool {
+	if x != nil {
+		return x.ClearOnSubmit
+	}
+	return false
+}
+
+type Image struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Src           string                 `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	MediaId       string                 `protobuf:"bytes,2,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Caption       string                 `protobuf:"bytes,4,opt,name=caption,proto3" json:"caption,omitempty"`
+	Width         *int32                 `protobuf:"varint,5,opt,name=width,proto3,oneof" json:"width,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Image) Reset() {
+	*x = Image{}
+	mi := &file_widget_v1_widget_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Image) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Image) ProtoMessage() {}
+
+func (x *Image) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Image.ProtoReflect.Descriptor instead.
+func (*Image) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Image) GetSrc() string {
+	if x != nil {
+		return x.Src
+	}
+	return ""
+}
+
+func (x *Image) GetMediaId() string {
+	if x != nil {
+		return x.MediaId
+	}
+	return ""
+}
+
+func (x *Image) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *Image) GetCaption() string {
+	if x != nil {
+		return x.Caption
+	}
+	return ""
+}
+
+func (x *Image) GetWidth() int32 {
+	if x != nil && x.Width != nil {
+		return *x.Width
+	}
+	return 0
+}
+
+type IntInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *int64                 `protobuf:"varint,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	DefaultValue  *int64                 `protobuf:"varint,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	MaxValue      *int64                 `protobuf:"varint,7,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"`
+	MinValue      *int64                 `protobuf:"varint,8,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
+	Step          *int64                 `protobuf:"varint,9,opt,name=step,proto3,oneof" json:"step,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntInput) Reset() {
+	*x = IntInput{}
+	mi := &file_widget_v1_widget_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntInput) ProtoMessage() {}
+
+func (x *IntInput) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntInput.ProtoReflect.Descriptor instead.
+func (*IntInput) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *IntInput) GetValue() int64 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+func (x *IntInput) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *IntInput) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *IntInput) GetDefaultValue() int64 {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return 0
+}
+
+func (x *IntInput) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *IntInput) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *IntInput) GetMaxValue() int64 {
+	if x != nil && x.MaxValue != nil {
+		return *x.MaxValue
+	}
+	return 0
+}
+
+func (x *IntInput) GetMinValue() int64 {
+	if x != nil && x.MinValue != nil {
+		return *x.MinValue
+	}
+	return 0
+}
+
+func (x *IntInput) GetStep() int64 {
+	if x != nil && x.Step != nil {
+		return *x.Step
+	}
+	return 0
+}
+
+type Json struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ExpandDepth   *int32                 `protobuf:"varint,2,opt,name=expand_depth,json=expandDepth,proto3,oneof" json:"expand_depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Json) Reset() {
+	*x = Json{}
+	mi := &file_widget_v1_widget_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Json) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Json) ProtoMessage() {}
+
+func (x *Json) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Json.ProtoReflect.Descriptor instead.
+func (*Json) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Json) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Json) GetExpandDepth() int32 {
+	if x != nil && x.ExpandDepth != nil {
+		return *x.ExpandDepth
+	}
+	return 0
+}
+
+type Markdown struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Markdown) Reset() {
+	*x = Markdown{}
+	mi := &file_widget_v1_widget_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Markdown) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Markdown) ProtoMessage() {}
+
+func (x *Markdown) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Markdown.ProtoReflect.Descriptor instead.
+func (*Markdown) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Markdown) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type Metric struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Label          string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Value          float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	FormattedValue string                 `protobuf:"bytes,3,opt,name=formatted_value,json=formattedValue,proto3" json:"formatted_value,omitempty"`
+	Delta          *float64               `protobuf:"fixed64,4,opt,name=delta,proto3,oneof" json:"delta,omitempty"`
+	FormattedDelta string                 `protobuf:"bytes,5,opt,name=formatted_delta,json=formattedDelta,proto3" json:"formatted_delta,omitempty"`
+	DeltaColor     string                 `protobuf:"bytes,6,opt,name=delta_color,json=deltaColor,proto3" json:"delta_color,omitempty"`
+	Help           string                 `protobuf:"bytes,7,opt,name=help,proto3" json:"help,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Metric) Reset() {
+	*x = Metric{}
+	mi := &file_widget_v1_widget_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Metric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metric) ProtoMessage() {}
+
+func (x *Metric) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metric.ProtoReflect.Descriptor instead.
+func (*Metric) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Metric) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Metric) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Metric) GetFormattedValue() string {
+	if x != nil {
+		return x.FormattedValue
+	}
+	return ""
+}
+
+func (x *Metric) GetDelta() float64 {
+	if x != nil && x.Delta != nil {
+		return *x.Delta
+	}
+	return 0
+}
+
+func (x *Metric) GetFormattedDelta() string {
+	if x != nil {
+		return x.FormattedDelta
+	}
+	return ""
+}
+
+func (x *Metric) GetDeltaColor() string {
+	if x != nil {
+		return x.DeltaColor
+	}
+	return ""
+}
+
+func (x *Metric) GetHelp() string {
+	if x != nil {
+		return x.Help
+	}
+	return ""
+}
+
+type MultiSelect struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         []int32                `protobuf:"varint,1,rep,packed,name=value,proto3" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Options       []string               `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,4,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	DefaultValue  []int32                `protobuf:"varint,5,rep,packed,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Searchable    bool                   `protobuf:"varint,8,opt,name=searchable,proto3" json:"searchable,omitempty"`
+	SearchValues  []string               `protobuf:"bytes,9,rep,name=search_values,json=searchValues,proto3" json:"search_values,omitempty"`
+	SearchLabels  []string               `protobuf:"bytes,10,rep,name=search_labels,json=searchLabels,proto3" json:"search_labels,omitempty"`
+	Creatable     bool                   `protobuf:"varint,11,opt,name=creatable,proto3" json:"creatable,omitempty"`
+	CreatedValues []string               `protobuf:"bytes,12,rep,name=created_values,json=createdValues,proto3" json:"created_values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiSelect) Reset() {
+	*x = MultiSelect{}
+	mi := &file_widget_v1_widget_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiSelect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiSelect) ProtoMessage() {}
+
+func (x *MultiSelect) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiSelect.ProtoReflect.Descriptor instead.
+func (*MultiSelect) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MultiSelect) GetValue() []int32 {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *MultiSelect) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MultiSelect) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *MultiSelect) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *MultiSelect) GetDefaultValue() []int32 {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return nil
+}
+
+func (x *MultiSelect) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *MultiSelect) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *MultiSelect) GetSearchable() bool {
+	if x != nil {
+		return x.Searchable
+	}
+	return false
+}
+
+func (x *MultiSelect) GetSearchValues() []string {
+	if x != nil {
+		return x.SearchValues
+	}
+	return nil
+}
+
+func (x *MultiSelect) GetSearchLabels() []string {
+	if x != nil {
+		return x.SearchLabels
+	}
+	return nil
+}
+
+func (x *MultiSelect) GetCreatable() bool {
+	if x != nil {
+		return x.Creatable
+	}
+	return false
+}
+
+func (x *MultiSelect) GetCreatedValues() []string {
+	if x != nil {
+		return x.CreatedValues
+	}
+	return nil
+}
+
+type NumberInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *float64               `protobuf:"fixed64,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	DefaultValue  *float64               `protobuf:"fixed64,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	MaxValue      *float64               `protobuf:"fixed64,7,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"`
+	MinValue      *float64               `protobuf:"fixed64,8,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
+	Step          *float64               `protobuf:"fixed64,9,opt,name=step,proto3,oneof" json:"step,omitempty"`
+	Precision     *int32                 `protobuf:"varint,10,opt,name=precision,proto3,oneof" json:"precision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NumberInput) Reset() {
+	*x = NumberInput{}
+	mi := &file_widget_v1_widget_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NumberInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NumberInput) ProtoMessage() {}
+
+func (x *NumberInput) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NumberInput.ProtoReflect.Descriptor instead.
+func (*NumberInput) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *NumberInput) GetValue() float64 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+func (x *NumberInput) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *NumberInput) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *NumberInput) GetDefaultValue() float64 {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return 0
+}
+
+func (x *NumberInput) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *NumberInput) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *NumberInput) GetMaxValue() float64 {
+	if x != nil && x.MaxValue != nil {
+		return *x.MaxValue
+	}
+	return 0
+}
+
+func (x *NumberInput) GetMinValue() float64 {
+	if x != nil && x.MinValue != nil {
+		return *x.MinValue
+	}
+	return 0
+}
+
+func (x *NumberInput) GetStep() float64 {
+	if x != nil && x.Step != nil {
+		return *x.Step
+	}
+	return 0
+}
+
+func (x *NumberInput) GetPrecision() int32 {
+	if x != nil && x.Precision != nil {
+		return *x.Precision
+	}
+	return 0
+}
+
+type Progress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Progress) Reset() {
+	*x = Progress{}
+	mi := &file_widget_v1_widget_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Progress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Progress) ProtoMessage() {}
+
+func (x *Progress) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Progress.ProtoReflect.Descriptor instead.
+func (*Progress) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Progress) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Progress) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Progress) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type Radio struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *int32                 `protobuf:"varint,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Options       []string               `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	DefaultValue  *int32                 `protobuf:"varint,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Radio) Reset() {
+	*x = Radio{}
+	mi := &file_widget_v1_widget_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Radio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Radio) ProtoMessage() {}
+
+func (x *Radio) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Radio.ProtoReflect.Descriptor instead.
+func (*Radio) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Radio) GetValue() int32 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+func (x *Radio) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Radio) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Radio) GetDefaultValue() int32 {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
 	}
-	return ""
+	return 0
 }
 
-func (x *DateTimeInput) GetMinValue() string {
+func (x *Radio) GetRequired() bool {
 	if x != nil {
-		return x.MinValue
+		return x.Required
 	}
-	return ""
+	return false
 }
 
-type Form struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Value          bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
-	ButtonLabel    string                 `protobuf:"bytes,2,opt,name=button_label,json=buttonLabel,proto3" json:"button_label,omitempty"`
-	ButtonDisabled bool                   `protobuf:"varint,3,opt,name=button_disabled,json=buttonDisabled,proto3" json:"button_disabled,omitempty"`
-	ClearOnSubmit  bool                   `protobuf:"varint,4,opt,name=clear_on_submit,json=clearOnSubmit,proto3" json:"clear_on_submit,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *Radio) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
 }
 
-func (x *Form) Reset() {
-	*x = Form{}
-	mi := &file_widget_v1_widget_proto_msgTypes[7]
+type RangeSlider struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LowValue         float64                `protobuf:"fixed64,1,opt,name=low_value,json=lowValue,proto3" json:"low_value,omitempty"`
+	HighValue        float64                `protobuf:"fixed64,2,opt,name=high_value,json=highValue,proto3" json:"high_value,omitempty"`
+	Label            string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	DefaultLowValue  float64                `protobuf:"fixed64,4,opt,name=default_low_value,json=defaultLowValue,proto3" json:"default_low_value,omitempty"`
+	DefaultHighValue float64                `protobuf:"fixed64,5,opt,name=default_high_value,json=defaultHighValue,proto3" json:"default_high_value,omitempty"`
+	MinValue         float64                `protobuf:"fixed64,6,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue         float64                `protobuf:"fixed64,7,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	Step             float64                `protobuf:"fixed64,8,opt,name=step,proto3" json:"step,omitempty"`
+	Format           string                 `protobuf:"bytes,9,opt,name=format,proto3" json:"format,omitempty"`
+	Disabled         bool                   `protobuf:"varint,10,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RangeSlider) Reset() {
+	*x = RangeSlider{}
+	mi := &file_widget_v1_widget_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Form) String() string {
+func (x *RangeSlider) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Form) ProtoMessage() {}
+func (*RangeSlider) ProtoMessage() {}
 
-func (x *Form) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[7]
+func (x *RangeSlider) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,111 +2214,108 @@ func (x *Form) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Form.ProtoReflect.Descriptor instead.
-func (*Form) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use RangeSlider.ProtoReflect.Descriptor instead.
+func (*RangeSlider) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Form) GetValue() bool {
+func (x *RangeSlider) GetLowValue() float64 {
 	if x != nil {
-		return x.Value
+		return x.LowValue
 	}
-	return false
+	return 0
 }
 
-func (x *Form) GetButtonLabel() string {
+func (x *RangeSlider) GetHighValue() float64 {
 	if x != nil {
-		return x.ButtonLabel
+		return x.HighValue
 	}
-	return ""
+	return 0
 }
 
-func (x *Form) GetButtonDisabled() bool {
+func (x *RangeSlider) GetLabel() string {
 	if x != nil {
-		return x.ButtonDisabled
+		return x.Label
 	}
-	return false
+	return ""
 }
 
-func (x *Form) GetClearOnSubmit() bool {
+func (x *RangeSlider) GetDefaultLowValue() float64 {
 	if x != nil {
-		return x.ClearOnSubmit
+		return x.DefaultLowValue
 	}
-	return false
+	return 0
 }
 
-type Markdown struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Body          string                 `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RangeSlider) GetDefaultHighValue() float64 {
+	if x != nil {
+		return x.DefaultHighValue
+	}
+	return 0
 }
 
-func (x *Markdown) Reset() {
-	*x = Markdown{}
-	mi := &file_widget_v1_widget_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RangeSlider) GetMinValue() float64 {
+	if x != nil {
+		return x.MinValue
+	}
+	return 0
 }
 
-func (x *Markdown) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RangeSlider) GetMaxValue() float64 {
+	if x != nil {
+		return x.MaxValue
+	}
+	return 0
 }
 
-func (*Markdown) ProtoMessage() {}
-
-func (x *Markdown) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[8]
+func (x *RangeSlider) GetStep() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Step
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use Markdown.ProtoReflect.Descriptor instead.
-func (*Markdown) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{8}
+func (x *RangeSlider) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
 }
 
-func (x *Markdown) GetBody() string {
+func (x *RangeSlider) GetDisabled() bool {
 	if x != nil {
-		return x.Body
+		return x.Disabled
 	}
-	return ""
+	return false
 }
 
-type MultiSelect struct {
+type Rating struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         []int32                `protobuf:"varint,1,rep,packed,name=value,proto3" json:"value,omitempty"`
+	Value         int32                  `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Options       []string               `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
-	Placeholder   string                 `protobuf:"bytes,4,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
-	DefaultValue  []int32                `protobuf:"varint,5,rep,packed,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
-	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
-	Disabled      bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	MaxValue      int32                  `protobuf:"varint,3,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	DefaultValue  int32                  `protobuf:"varint,4,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MultiSelect) Reset() {
-	*x = MultiSelect{}
-	mi := &file_widget_v1_widget_proto_msgTypes[9]
+func (x *Rating) Reset() {
+	*x = Rating{}
+	mi := &file_widget_v1_widget_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MultiSelect) String() string {
+func (x *Rating) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiSelect) ProtoMessage() {}
+func (*Rating) ProtoMessage() {}
 
-func (x *MultiSelect) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[9]
+func (x *Rating) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,89 +2326,84 @@ func (x *MultiSelect) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiSelect.ProtoReflect.Descriptor instead.
-func (*MultiSelect) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Rating.ProtoReflect.Descriptor instead.
+func (*Rating) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *MultiSelect) GetValue() []int32 {
+func (x *Rating) GetValue() int32 {
 	if x != nil {
 		return x.Value
 	}
-	return nil
+	return 0
 }
 
-func (x *MultiSelect) GetLabel() string {
+func (x *Rating) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *MultiSelect) GetOptions() []string {
-	if x != nil {
-		return x.Options
-	}
-	return nil
-}
-
-func (x *MultiSelect) GetPlaceholder() string {
+func (x *Rating) GetMaxValue() int32 {
 	if x != nil {
-		return x.Placeholder
+		return x.MaxValue
 	}
-	return ""
+	return 0
 }
 
-func (x *MultiSelect) GetDefaultValue() []int32 {
+func (x *Rating) GetDefaultValue() int32 {
 	if x != nil {
 		return x.DefaultValue
 	}
-	return nil
+	return 0
 }
 
-func (x *MultiSelect) GetRequired() bool {
+func (x *Rating) GetRequired() bool {
 	if x != nil {
 		return x.Required
 	}
 	return false
 }
 
-func (x *MultiSelect) GetDisabled() bool {
+func (x *Rating) GetDisabled() bool {
 	if x != nil {
 		return x.Disabled
 	}
 	return false
 }
 
-type NumberInput struct {
+type Selectbox struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         *float64               `protobuf:"fixed64,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Value         *int32                 `protobuf:"varint,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
 	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
-	DefaultValue  *float64               `protobuf:"fixed64,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
-	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
-	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	MaxValue      *float64               `protobuf:"fixed64,7,opt,name=max_value,json=maxValue,proto3,oneof" json:"max_value,omitempty"`
-	MinValue      *float64               `protobuf:"fixed64,8,opt,name=min_value,json=minValue,proto3,oneof" json:"min_value,omitempty"`
+	Options       []string               `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,4,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	DefaultValue  *int32                 `protobuf:"varint,5,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Searchable    bool                   `protobuf:"varint,8,opt,name=searchable,proto3" json:"searchable,omitempty"`
+	SearchValue   *string                `protobuf:"bytes,9,opt,name=search_value,json=searchValue,proto3,oneof" json:"search_value,omitempty"`
+	SearchLabel   *string                `protobuf:"bytes,10,opt,name=search_label,json=searchLabel,proto3,oneof" json:"search_label,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NumberInput) Reset() {
-	*x = NumberInput{}
-	mi := &file_widget_v1_widget_proto_msgTypes[10]
+func (x *Selectbox) Reset() {
+	*x = Selectbox{}
+	mi := &file_widget_v1_widget_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NumberInput) String() string {
+func (x *Selectbox) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NumberInput) ProtoMessage() {}
+func (*Selectbox) ProtoMessage() {}
 
-func (x *NumberInput) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[10]
+func (x *Selectbox) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -788,94 +2414,110 @@ func (x *NumberInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NumberInput.ProtoReflect.Descriptor instead.
-func (*NumberInput) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use Selectbox.ProtoReflect.Descriptor instead.
+func (*Selectbox) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *NumberInput) GetValue() float64 {
+func (x *Selectbox) GetValue() int32 {
 	if x != nil && x.Value != nil {
 		return *x.Value
 	}
 	return 0
 }
 
-func (x *NumberInput) GetLabel() string {
+func (x *Selectbox) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *NumberInput) GetPlaceholder() string {
+func (x *Selectbox) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Selectbox) GetPlaceholder() string {
 	if x != nil {
 		return x.Placeholder
 	}
 	return ""
 }
 
-func (x *NumberInput) GetDefaultValue() float64 {
+func (x *Selectbox) GetDefaultValue() int32 {
 	if x != nil && x.DefaultValue != nil {
 		return *x.DefaultValue
 	}
 	return 0
 }
 
-func (x *NumberInput) GetRequired() bool {
+func (x *Selectbox) GetRequired() bool {
 	if x != nil {
 		return x.Required
 	}
 	return false
 }
 
-func (x *NumberInput) GetDisabled() bool {
+func (x *Selectbox) GetDisabled() bool {
 	if x != nil {
 		return x.Disabled
 	}
 	return false
 }
 
-func (x *NumberInput) GetMaxValue() float64 {
-	if x != nil && x.MaxValue != nil {
-		return *x.MaxValue
+func (x *Selectbox) GetSearchable() bool {
+	if x != nil {
+		return x.Searchable
 	}
-	return 0
+	return false
 }
 
-func (x *NumberInput) GetMinValue() float64 {
-	if x != nil && x.MinValue != nil {
-		return *x.MinValue
+func (x *Selectbox) GetSearchValue() string {
+	if x != nil && x.SearchValue != nil {
+		return *x.SearchValue
 	}
-	return 0
+	return ""
 }
 
-type Radio struct {
+func (x *Selectbox) GetSearchLabel() string {
+	if x != nil && x.SearchLabel != nil {
+		return *x.SearchLabel
+	}
+	return ""
+}
+
+type Slider struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         *int32                 `protobuf:"varint,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Value         float64                `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
 	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Options       []string               `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
-	DefaultValue  *int32                 `protobuf:"varint,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
-	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
-	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	DefaultValue  float64                `protobuf:"fixed64,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	MinValue      float64                `protobuf:"fixed64,4,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue      float64                `protobuf:"fixed64,5,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	Step          float64                `protobuf:"fixed64,6,opt,name=step,proto3" json:"step,omitempty"`
+	Format        string                 `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`
+	Disabled      bool                   `protobuf:"varint,8,opt,name=disabled,proto3" json:"disabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Radio) Reset() {
-	*x = Radio{}
-	mi := &file_widget_v1_widget_proto_msgTypes[11]
+func (x *Slider) Reset() {
+	*x = Slider{}
+	mi := &file_widget_v1_widget_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Radio) String() string {
+func (x *Slider) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Radio) ProtoMessage() {}
+func (*Slider) ProtoMessage() {}
 
-func (x *Radio) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[11]
+func (x *Slider) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,81 +2528,90 @@ func (x *Radio) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Radio.ProtoReflect.Descriptor instead.
-func (*Radio) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use Slider.ProtoReflect.Descriptor instead.
+func (*Slider) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *Radio) GetValue() int32 {
-	if x != nil && x.Value != nil {
-		return *x.Value
+func (x *Slider) GetValue() float64 {
+	if x != nil {
+		return x.Value
 	}
 	return 0
 }
 
-func (x *Radio) GetLabel() string {
+func (x *Slider) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *Radio) GetOptions() []string {
+func (x *Slider) GetDefaultValue() float64 {
 	if x != nil {
-		return x.Options
+		return x.DefaultValue
 	}
-	return nil
+	return 0
 }
 
-func (x *Radio) GetDefaultValue() int32 {
-	if x != nil && x.DefaultValue != nil {
-		return *x.DefaultValue
+func (x *Slider) GetMinValue() float64 {
+	if x != nil {
+		return x.MinValue
 	}
 	return 0
 }
 
-func (x *Radio) GetRequired() bool {
+func (x *Slider) GetMaxValue() float64 {
 	if x != nil {
-		return x.Required
+		return x.MaxValue
 	}
-	return false
+	return 0
+}
+
+func (x *Slider) GetStep() float64 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *Slider) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
 }
 
-func (x *Radio) GetDisabled() bool {
+func (x *Slider) GetDisabled() bool {
 	if x != nil {
 		return x.Disabled
 	}
 	return false
 }
 
-type Selectbox struct {
+type Spinner struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         *int32                 `protobuf:"varint,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Options       []string               `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty"`
-	Placeholder   string                 `protobuf:"bytes,4,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
-	DefaultValue  *int32                 `protobuf:"varint,5,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
-	Required      bool                   `protobuf:"varint,6,opt,name=required,proto3" json:"required,omitempty"`
-	Disabled      bool                   `protobuf:"varint,7,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Done          bool                   `protobuf:"varint,2,opt,name=done,proto3" json:"done,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Selectbox) Reset() {
-	*x = Selectbox{}
-	mi := &file_widget_v1_widget_proto_msgTypes[12]
+func (x *Spinner) Reset() {
+	*x = Spinner{}
+	mi := &file_widget_v1_widget_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Selectbox) String() string {
+func (x *Spinner) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Selectbox) ProtoMessage() {}
+func (*Spinner) ProtoMessage() {}
 
-func (x *Selectbox) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[12]
+func (x *Spinner) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -971,56 +2622,21 @@ func (x *Selectbox) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Selectbox.ProtoReflect.Descriptor instead.
-func (*Selectbox) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *Selectbox) GetValue() int32 {
-	if x != nil && x.Value != nil {
-		return *x.Value
-	}
-	return 0
+// Deprecated: Use Spinner.ProtoReflect.Descriptor instead.
+func (*Spinner) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Selectbox) GetLabel() string {
+func (x *Spinner) GetLabel() string {
 	if x != nil {
 		return x.Label
 	}
 	return ""
 }
 
-func (x *Selectbox) GetOptions() []string {
-	if x != nil {
-		return x.Options
-	}
-	return nil
-}
-
-func (x *Selectbox) GetPlaceholder() string {
-	if x != nil {
-		return x.Placeholder
-	}
-	return ""
-}
-
-func (x *Selectbox) GetDefaultValue() int32 {
-	if x != nil && x.DefaultValue != nil {
-		return *x.DefaultValue
-	}
-	return 0
-}
-
-func (x *Selectbox) GetRequired() bool {
-	if x != nil {
-		return x.Required
-	}
-	return false
-}
-
-func (x *Selectbox) GetDisabled() bool {
+func (x *Spinner) GetDone() bool {
 	if x != nil {
-		return x.Disabled
+		return x.Done
 	}
 	return false
 }
@@ -1041,7 +2657,7 @@ type Table struct {
 
 func (x *Table) Reset() {
 	*x = Table{}
-	mi := &file_widget_v1_widget_proto_msgTypes[13]
+	mi := &file_widget_v1_widget_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1053,7 +2669,7 @@ func (x *Table) String() string {
 func (*Table) ProtoMessage() {}
 
 func (x *Table) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[13]
+	mi := &file_widget_v1_widget_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1066,7 +2682,7 @@ func (x *Table) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Table.ProtoReflect.Descriptor instead.
 func (*Table) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{13}
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *Table) GetData() []byte {
@@ -1134,7 +2750,7 @@ type TableValue struct {
 
 func (x *TableValue) Reset() {
 	*x = TableValue{}
-	mi := &file_widget_v1_widget_proto_msgTypes[14]
+	mi := &file_widget_v1_widget_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1146,7 +2762,7 @@ func (x *TableValue) String() string {
 func (*TableValue) ProtoMessage() {}
 
 func (x *TableValue) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[14]
+	mi := &file_widget_v1_widget_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,7 +2775,7 @@ func (x *TableValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TableValue.ProtoReflect.Descriptor instead.
 func (*TableValue) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{14}
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *TableValue) GetSelection() *TableValueSelection {
@@ -1179,7 +2795,7 @@ type TableValueSelection struct {
 
 func (x *TableValueSelection) Reset() {
 	*x = TableValueSelection{}
-	mi := &file_widget_v1_widget_proto_msgTypes[15]
+	mi := &file_widget_v1_widget_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1191,7 +2807,7 @@ func (x *TableValueSelection) String() string {
 func (*TableValueSelection) ProtoMessage() {}
 
 func (x *TableValueSelection) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[15]
+	mi := &file_widget_v1_widget_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1204,7 +2820,7 @@ func (x *TableValueSelection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TableValueSelection.ProtoReflect.Descriptor instead.
 func (*TableValueSelection) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{15}
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *TableValueSelection) GetRow() int32 {
@@ -1240,7 +2856,7 @@ type TextArea struct {
 
 func (x *TextArea) Reset() {
 	*x = TextArea{}
-	mi := &file_widget_v1_widget_proto_msgTypes[16]
+	mi := &file_widget_v1_widget_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1252,7 +2868,7 @@ func (x *TextArea) String() string {
 func (*TextArea) ProtoMessage() {}
 
 func (x *TextArea) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[16]
+	mi := &file_widget_v1_widget_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1265,7 +2881,7 @@ func (x *TextArea) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TextArea.ProtoReflect.Descriptor instead.
 func (*TextArea) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{16}
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *TextArea) GetValue() string {
@@ -1361,7 +2977,7 @@ type TextInput struct {
 
 func (x *TextInput) Reset() {
 	*x = TextInput{}
-	mi := &file_widget_v1_widget_proto_msgTypes[17]
+	mi := &file_widget_v1_widget_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1373,7 +2989,7 @@ func (x *TextInput) String() string {
 func (*TextInput) ProtoMessage() {}
 
 func (x *TextInput) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[17]
+	mi := &file_widget_v1_widget_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,7 +3002,7 @@ func (x *TextInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TextInput.ProtoReflect.Descriptor instead.
 func (*TextInput) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{17}
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *TextInput) GetValue() string {
@@ -1424,54 +3040,276 @@ func (x *TextInput) GetRequired() bool {
 	return false
 }
 
-func (x *TextInput) GetDisabled() bool {
+func (x *TextInput) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *TextInput) GetMaxLength() int32 {
+	if x != nil && x.MaxLength != nil {
+		return *x.MaxLength
+	}
+	return 0
+}
+
+func (x *TextInput) GetMinLength() int32 {
+	if x != nil && x.MinLength != nil {
+		return *x.MinLength
+	}
+	return 0
+}
+
+type TimeInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         *string                `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	DefaultValue  *string                `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeInput) Reset() {
+	*x = TimeInput{}
+	mi := &file_widget_v1_widget_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeInput) ProtoMessage() {}
+
+func (x *TimeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeInput.ProtoReflect.Descriptor instead.
+func (*TimeInput) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TimeInput) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+func (x *TimeInput) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *TimeInput) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *TimeInput) GetDefaultValue() string {
+	if x != nil && x.DefaultValue != nil {
+		return *x.DefaultValue
+	}
+	return ""
+}
+
+func (x *TimeInput) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *TimeInput) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type Toggle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         bool                   `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	DefaultValue  bool                   `protobuf:"varint,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Disabled      bool                   `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Toggle) Reset() {
+	*x = Toggle{}
+	mi := &file_widget_v1_widget_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Toggle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Toggle) ProtoMessage() {}
+
+func (x *Toggle) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Toggle.ProtoReflect.Descriptor instead.
+func (*Toggle) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Toggle) GetValue() bool {
+	if x != nil {
+		return x.Value
+	}
+	return false
+}
+
+func (x *Toggle) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Toggle) GetDefaultValue() bool {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return false
+}
+
+func (x *Toggle) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type UploadedFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Size          int64                  `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadedFile) Reset() {
+	*x = UploadedFile{}
+	mi := &file_widget_v1_widget_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadedFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadedFile) ProtoMessage() {}
+
+func (x *UploadedFile) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadedFile.ProtoReflect.Descriptor instead.
+func (*UploadedFile) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *UploadedFile) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UploadedFile) GetName() string {
 	if x != nil {
-		return x.Disabled
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *TextInput) GetMaxLength() int32 {
-	if x != nil && x.MaxLength != nil {
-		return *x.MaxLength
+func (x *UploadedFile) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
 	}
-	return 0
+	return ""
 }
 
-func (x *TextInput) GetMinLength() int32 {
-	if x != nil && x.MinLength != nil {
-		return *x.MinLength
+func (x *UploadedFile) GetSize() int64 {
+	if x != nil {
+		return x.Size
 	}
 	return 0
 }
 
-type TimeInput struct {
+type Video struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Value         *string                `protobuf:"bytes,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
-	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Placeholder   string                 `protobuf:"bytes,3,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
-	DefaultValue  *string                `protobuf:"bytes,4,opt,name=default_value,json=defaultValue,proto3,oneof" json:"default_value,omitempty"`
-	Required      bool                   `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
-	Disabled      bool                   `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Src           string                 `protobuf:"bytes,1,opt,name=src,proto3" json:"src,omitempty"`
+	MediaId       string                 `protobuf:"bytes,2,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
+	MimeType      string                 `protobuf:"bytes,3,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	Caption       string                 `protobuf:"bytes,4,opt,name=caption,proto3" json:"caption,omitempty"`
+	Width         *int32                 `protobuf:"varint,5,opt,name=width,proto3,oneof" json:"width,omitempty"`
+	Autoplay      bool                   `protobuf:"varint,6,opt,name=autoplay,proto3" json:"autoplay,omitempty"`
+	Loop          bool                   `protobuf:"varint,7,opt,name=loop,proto3" json:"loop,omitempty"`
+	Muted         bool                   `protobuf:"varint,8,opt,name=muted,proto3" json:"muted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TimeInput) Reset() {
-	*x = TimeInput{}
-	mi := &file_widget_v1_widget_proto_msgTypes[18]
+func (x *Video) Reset() {
+	*x = Video{}
+	mi := &file_widget_v1_widget_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TimeInput) String() string {
+func (x *Video) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TimeInput) ProtoMessage() {}
+func (*Video) ProtoMessage() {}
 
-func (x *TimeInput) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[18]
+func (x *Video) ProtoReflect() protoreflect.Message {
+	mi := &file_widget_v1_widget_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1482,49 +3320,63 @@ func (x *TimeInput) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TimeInput.ProtoReflect.Descriptor instead.
-func (*TimeInput) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use Video.ProtoReflect.Descriptor instead.
+func (*Video) Descriptor() ([]byte, []int) {
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *TimeInput) GetValue() string {
-	if x != nil && x.Value != nil {
-		return *x.Value
+func (x *Video) GetSrc() string {
+	if x != nil {
+		return x.Src
 	}
 	return ""
 }
 
-func (x *TimeInput) GetLabel() string {
+func (x *Video) GetMediaId() string {
 	if x != nil {
-		return x.Label
+		return x.MediaId
 	}
 	return ""
 }
 
-func (x *TimeInput) GetPlaceholder() string {
+func (x *Video) GetMimeType() string {
 	if x != nil {
-		return x.Placeholder
+		return x.MimeType
 	}
 	return ""
 }
 
-func (x *TimeInput) GetDefaultValue() string {
-	if x != nil && x.DefaultValue != nil {
-		return *x.DefaultValue
+func (x *Video) GetCaption() string {
+	if x != nil {
+		return x.Caption
 	}
 	return ""
 }
 
-func (x *TimeInput) GetRequired() bool {
+func (x *Video) GetWidth() int32 {
+	if x != nil && x.Width != nil {
+		return *x.Width
+	}
+	return 0
+}
+
+func (x *Video) GetAutoplay() bool {
 	if x != nil {
-		return x.Required
+		return x.Autoplay
 	}
 	return false
 }
 
-func (x *TimeInput) GetDisabled() bool {
+func (x *Video) GetLoop() bool {
 	if x != nil {
-		return x.Disabled
+		return x.Loop
+	}
+	return false
+}
+
+func (x *Video) GetMuted() bool {
+	if x != nil {
+		return x.Muted
 	}
 	return false
 }
@@ -1551,6 +3403,25 @@ type Widget struct {
 	//	*Widget_TextArea
 	//	*Widget_TextInput
 	//	*Widget_TimeInput
+	//	*Widget_Alert
+	//	*Widget_Metric
+	//	*Widget_Progress
+	//	*Widget_Spinner
+	//	*Widget_FileUploader
+	//	*Widget_DownloadButton
+	//	*Widget_Image
+	//	*Widget_Video
+	//	*Widget_Audio
+	//	*Widget_Code
+	//	*Widget_Json
+	//	*Widget_Slider
+	//	*Widget_RangeSlider
+	//	*Widget_DateRangeSlider
+	//	*Widget_DateRangeInput
+	//	*Widget_Toggle
+	//	*Widget_ColorPicker
+	//	*Widget_Rating
+	//	*Widget_IntInput
 	Type          isWidget_Type `protobuf_oneof:"type"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -1558,7 +3429,7 @@ type Widget struct {
 
 func (x *Widget) Reset() {
 	*x = Widget{}
-	mi := &file_widget_v1_widget_proto_msgTypes[19]
+	mi := &file_widget_v1_widget_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1570,7 +3441,7 @@ func (x *Widget) String() string {
 func (*Widget) ProtoMessage() {}
 
 func (x *Widget) ProtoReflect() protoreflect.Message {
-	mi := &file_widget_v1_widget_proto_msgTypes[19]
+	mi := &file_widget_v1_widget_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1583,7 +3454,7 @@ func (x *Widget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Widget.ProtoReflect.Descriptor instead.
 func (*Widget) Descriptor() ([]byte, []int) {
-	return file_widget_v1_widget_proto_rawDescGZIP(), []int{19}
+	return file_widget_v1_widget_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *Widget) GetId() string {
@@ -1753,6 +3624,177 @@ func (x *Widget) GetTimeInput() *TimeInput {
 	return nil
 }
 
+func (x *Widget) GetAlert() *Alert {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Alert); ok {
+			return x.Alert
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetMetric() *Metric {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Metric); ok {
+			return x.Metric
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetProgress() *Progress {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Progress); ok {
+			return x.Progress
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetSpinner() *Spinner {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Spinner); ok {
+			return x.Spinner
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetFileUploader() *FileUploader {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_FileUploader); ok {
+			return x.FileUploader
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetDownloadButton() *DownloadButton {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_DownloadButton); ok {
+			return x.DownloadButton
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetImage() *Image {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Image); ok {
+			return x.Image
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetVideo() *Video {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Video); ok {
+			return x.Video
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetAudio() *Audio {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Audio); ok {
+			return x.Audio
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetCode() *Code {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Code); ok {
+			return x.Code
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetJson() *Json {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Json); ok {
+			return x.Json
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetSlider() *Slider {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Slider); ok {
+			return x.Slider
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetRangeSlider() *RangeSlider {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_RangeSlider); ok {
+			return x.RangeSlider
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetDateRangeSlider() *DateRangeSlider {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_DateRangeSlider); ok {
+			return x.DateRangeSlider
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetDateRangeInput() *DateRangeInput {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_DateRangeInput); ok {
+			return x.DateRangeInput
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetToggle() *Toggle {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Toggle); ok {
+			return x.Toggle
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetColorPicker() *ColorPicker {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_ColorPicker); ok {
+			return x.ColorPicker
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetRating() *Rating {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_Rating); ok {
+			return x.Rating
+		}
+	}
+	return nil
+}
+
+func (x *Widget) GetIntInput() *IntInput {
+	if x != nil {
+		if x, ok := x.Type.(*Widget_IntInput); ok {
+			return x.IntInput
+		}
+	}
+	return nil
+}
+
 type isWidget_Type interface {
 	isWidget_Type()
 }
@@ -1825,6 +3867,82 @@ type Widget_TimeInput struct {
 	TimeInput *TimeInput `protobuf:"bytes,18,opt,name=time_input,json=timeInput,proto3,oneof"`
 }
 
+type Widget_Alert struct {
+	Alert *Alert `protobuf:"bytes,19,opt,name=alert,proto3,oneof"`
+}
+
+type Widget_Metric struct {
+	Metric *Metric `protobuf:"bytes,20,opt,name=metric,proto3,oneof"`
+}
+
+type Widget_Progress struct {
+	Progress *Progress `protobuf:"bytes,21,opt,name=progress,proto3,oneof"`
+}
+
+type Widget_Spinner struct {
+	Spinner *Spinner `protobuf:"bytes,22,opt,name=spinner,proto3,oneof"`
+}
+
+type Widget_FileUploader struct {
+	FileUploader *FileUploader `protobuf:"bytes,23,opt,name=file_uploader,json=fileUploader,proto3,oneof"`
+}
+
+type Widget_DownloadButton struct {
+	DownloadButton *DownloadButton `protobuf:"bytes,24,opt,name=download_button,json=downloadButton,proto3,oneof"`
+}
+
+type Widget_Image struct {
+	Image *Image `protobuf:"bytes,25,opt,name=image,proto3,oneof"`
+}
+
+type Widget_Video struct {
+	Video *Video `protobuf:"bytes,26,opt,name=video,proto3,oneof"`
+}
+
+type Widget_Audio struct {
+	Audio *Audio `protobuf:"bytes,27,opt,name=audio,proto3,oneof"`
+}
+
+type Widget_Code struct {
+	Code *Code `protobuf:"bytes,28,opt,name=code,proto3,oneof"`
+}
+
+type Widget_Json struct {
+	Json *Json `protobuf:"bytes,29,opt,name=json,proto3,oneof"`
+}
+
+type Widget_Slider struct {
+	Slider *Slider `protobuf:"bytes,30,opt,name=slider,proto3,oneof"`
+}
+
+type Widget_RangeSlider struct {
+	RangeSlider *RangeSlider `protobuf:"bytes,31,opt,name=range_slider,json=rangeSlider,proto3,oneof"`
+}
+
+type Widget_DateRangeSlider struct {
+	DateRangeSlider *DateRangeSlider `protobuf:"bytes,32,opt,name=date_range_slider,json=dateRangeSlider,proto3,oneof"`
+}
+
+type Widget_DateRangeInput struct {
+	DateRangeInput *DateRangeInput `protobuf:"bytes,33,opt,name=date_range_input,json=dateRangeInput,proto3,oneof"`
+}
+
+type Widget_Toggle struct {
+	Toggle *Toggle `protobuf:"bytes,34,opt,name=toggle,proto3,oneof"`
+}
+
+type Widget_ColorPicker struct {
+	ColorPicker *ColorPicker `protobuf:"bytes,35,opt,name=color_picker,json=colorPicker,proto3,oneof"`
+}
+
+type Widget_Rating struct {
+	Rating *Rating `protobuf:"bytes,36,opt,name=rating,proto3,oneof"`
+}
+
+type Widget_IntInput struct {
+	IntInput *IntInput `protobuf:"bytes,37,opt,name=int_input,json=intInput,proto3,oneof"`
+}
+
 func (*Widget_Button) isWidget_Type() {}
 
 func (*Widget_Checkbox) isWidget_Type() {}
@@ -1859,11 +3977,63 @@ func (*Widget_TextInput) isWidget_Type() {}
 
 func (*Widget_TimeInput) isWidget_Type() {}
 
+func (*Widget_Alert) isWidget_Type() {}
+
+func (*Widget_Metric) isWidget_Type() {}
+
+func (*Widget_Progress) isWidget_Type() {}
+
+func (*Widget_Spinner) isWidget_Type() {}
+
+func (*Widget_FileUploader) isWidget_Type() {}
+
+func (*Widget_DownloadButton) isWidget_Type() {}
+
+func (*Widget_Image) isWidget_Type() {}
+
+func (*Widget_Video) isWidget_Type() {}
+
+func (*Widget_Audio) isWidget_Type() {}
+
+func (*Widget_Code) isWidget_Type() {}
+
+func (*Widget_Json) isWidget_Type() {}
+
+func (*Widget_Slider) isWidget_Type() {}
+
+func (*Widget_RangeSlider) isWidget_Type() {}
+
+func (*Widget_DateRangeSlider) isWidget_Type() {}
+
+func (*Widget_DateRangeInput) isWidget_Type() {}
+
+func (*Widget_Toggle) isWidget_Type() {}
+
+func (*Widget_ColorPicker) isWidget_Type() {}
+
+func (*Widget_Rating) isWidget_Type() {}
+
+func (*Widget_IntInput) isWidget_Type() {}
+
 var File_widget_v1_widget_proto protoreflect.FileDescriptor
 
 const file_widget_v1_widget_proto_rawDesc = "" +
 	"\n" +
-	"\x16widget/v1/widget.proto\x12\twidget.v1\"P\n" +
+	"\x16widget/v1/widget.proto\x12\twidget.v1\"\x9f\x01\n" +
+	"\x05Alert\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04icon\x18\x04 \x01(\tR\x04icon\x12 \n" +
+	"\vdismissible\x18\x05 \x01(\bR\vdismissible\x12\x1c\n" +
+	"\tdismissed\x18\x06 \x01(\bR\tdismissed\"\x9b\x01\n" +
+	"\x05Audio\x12\x10\n" +
+	"\x03src\x18\x01 \x01(\tR\x03src\x12\x19\n" +
+	"\bmedia_id\x18\x02 \x01(\tR\amediaId\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12\x18\n" +
+	"\acaption\x18\x04 \x01(\tR\acaption\x12\x1a\n" +
+	"\bautoplay\x18\x05 \x01(\bR\bautoplay\x12\x12\n" +
+	"\x04loop\x18\x06 \x01(\bR\x04loop\"P\n" +
 	"\x06Button\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\bR\x05value\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1a\n" +
@@ -1880,7 +4050,19 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\aoptions\x18\x03 \x03(\tR\aoptions\x12#\n" +
 	"\rdefault_value\x18\x04 \x03(\x05R\fdefaultValue\x12\x1a\n" +
 	"\brequired\x18\x05 \x01(\bR\brequired\x12\x1a\n" +
-	"\bdisabled\x18\x06 \x01(\bR\bdisabled\"$\n" +
+	"\bdisabled\x18\x06 \x01(\bR\bdisabled\"\x96\x01\n" +
+	"\x04Code\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12!\n" +
+	"\fline_numbers\x18\x03 \x01(\bR\vlineNumbers\x12'\n" +
+	"\x0fhighlight_lines\x18\x04 \x03(\x05R\x0ehighlightLines\x12\x12\n" +
+	"\x04wrap\x18\x05 \x01(\bR\x04wrap\"\x96\x01\n" +
+	"\vColorPicker\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12#\n" +
+	"\rdefault_value\x18\x03 \x01(\tR\fdefaultValue\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\x1a\n" +
+	"\bdisabled\x18\x05 \x01(\bR\bdisabled\"$\n" +
 	"\n" +
 	"ColumnItem\x12\x16\n" +
 	"\x06weight\x18\x01 \x01(\x01R\x06weight\"#\n" +
@@ -1897,7 +4079,43 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\tmax_value\x18\b \x01(\tR\bmaxValue\x12\x1b\n" +
 	"\tmin_value\x18\t \x01(\tR\bminValueB\b\n" +
 	"\x06_valueB\x10\n" +
-	"\x0e_default_value\"\xb2\x02\n" +
+	"\x0e_default_value\"\xfb\x03\n" +
+	"\x0eDateRangeInput\x12\"\n" +
+	"\n" +
+	"from_value\x18\x01 \x01(\tH\x00R\tfromValue\x88\x01\x01\x12\x1e\n" +
+	"\bto_value\x18\x02 \x01(\tH\x01R\atoValue\x88\x01\x01\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12 \n" +
+	"\vplaceholder\x18\x04 \x01(\tR\vplaceholder\x121\n" +
+	"\x12default_from_value\x18\x05 \x01(\tH\x02R\x10defaultFromValue\x88\x01\x01\x12-\n" +
+	"\x10default_to_value\x18\x06 \x01(\tH\x03R\x0edefaultToValue\x88\x01\x01\x12\x1a\n" +
+	"\brequired\x18\a \x01(\bR\brequired\x12\x1a\n" +
+	"\bdisabled\x18\b \x01(\bR\bdisabled\x12\x16\n" +
+	"\x06format\x18\t \x01(\tR\x06format\x12\x1b\n" +
+	"\tmax_value\x18\n" +
+	" \x01(\tR\bmaxValue\x12\x1b\n" +
+	"\tmin_value\x18\v \x01(\tR\bminValue\x129\n" +
+	"\apresets\x18\f \x03(\v2\x1f.widget.v1.DateRangeInputPresetR\apresetsB\r\n" +
+	"\v_from_valueB\v\n" +
+	"\t_to_valueB\x15\n" +
+	"\x13_default_from_valueB\x13\n" +
+	"\x11_default_to_value\"P\n" +
+	"\x14DateRangeInputPreset\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\xbf\x02\n" +
+	"\x0fDateRangeSlider\x12\x1b\n" +
+	"\tlow_value\x18\x01 \x01(\tR\blowValue\x12\x1d\n" +
+	"\n" +
+	"high_value\x18\x02 \x01(\tR\thighValue\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12*\n" +
+	"\x11default_low_value\x18\x04 \x01(\tR\x0fdefaultLowValue\x12,\n" +
+	"\x12default_high_value\x18\x05 \x01(\tR\x10defaultHighValue\x12\x1b\n" +
+	"\tmin_value\x18\x06 \x01(\tR\bminValue\x12\x1b\n" +
+	"\tmax_value\x18\a \x01(\tR\bmaxValue\x12\x12\n" +
+	"\x04step\x18\b \x01(\x05R\x04step\x12\x16\n" +
+	"\x06format\x18\t \x01(\tR\x06format\x12\x1a\n" +
+	"\bdisabled\x18\n" +
+	" \x01(\bR\bdisabled\"\xb2\x02\n" +
 	"\rDateTimeInput\x12\x19\n" +
 	"\x05value\x18\x01 \x01(\tH\x00R\x05value\x88\x01\x01\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12 \n" +
@@ -1909,14 +4127,67 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\tmax_value\x18\b \x01(\tR\bmaxValue\x12\x1b\n" +
 	"\tmin_value\x18\t \x01(\tR\bminValueB\b\n" +
 	"\x06_valueB\x10\n" +
-	"\x0e_default_value\"\x90\x01\n" +
+	"\x0e_default_value\"\x91\x01\n" +
+	"\x0eDownloadButton\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\bR\x05value\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12\x1b\n" +
+	"\tmime_type\x18\x04 \x01(\tR\bmimeType\x12\x1a\n" +
+	"\bdisabled\x18\x05 \x01(\bR\bdisabled\"\xec\x01\n" +
+	"\fFileUploader\x12-\n" +
+	"\x05value\x18\x01 \x03(\v2\x17.widget.v1.UploadedFileR\x05value\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x16\n" +
+	"\x06accept\x18\x03 \x03(\tR\x06accept\x12\x1e\n" +
+	"\bmax_size\x18\x04 \x01(\x03H\x00R\amaxSize\x88\x01\x01\x12\x1a\n" +
+	"\bmultiple\x18\x05 \x01(\bR\bmultiple\x12\x1a\n" +
+	"\brequired\x18\x06 \x01(\bR\brequired\x12\x1a\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabledB\v\n" +
+	"\t_max_size\"\x90\x01\n" +
 	"\x04Form\x12\x14\n" +
 	"\x05value\x18\x01 \x01(\bR\x05value\x12!\n" +
 	"\fbutton_label\x18\x02 \x01(\tR\vbuttonLabel\x12'\n" +
 	"\x0fbutton_disabled\x18\x03 \x01(\bR\x0ebuttonDisabled\x12&\n" +
-	"\x0fclear_on_submit\x18\x04 \x01(\bR\rclearOnSubmit\"\x1e\n" +
+	"\x0fclear_on_submit\x18\x04 \x01(\bR\rclearOnSubmit\"\x90\x01\n" +
+	"\x05Image\x12\x10\n" +
+	"\x03src\x18\x01 \x01(\tR\x03src\x12\x19\n" +
+	"\bmedia_id\x18\x02 \x01(\tR\amediaId\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12\x18\n" +
+	"\acaption\x18\x04 \x01(\tR\acaption\x12\x19\n" +
+	"\x05width\x18\x05 \x01(\x05H\x00R\x05width\x88\x01\x01B\b\n" +
+	"\x06_width\"\xdd\x02\n" +
+	"\bIntInput\x12\x19\n" +
+	"\x05value\x18\x01 \x01(\x03H\x00R\x05value\x88\x01\x01\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12 \n" +
+	"\vplaceholder\x18\x03 \x01(\tR\vplaceholder\x12(\n" +
+	"\rdefault_value\x18\x04 \x01(\x03H\x01R\fdefaultValue\x88\x01\x01\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12\x1a\n" +
+	"\bdisabled\x18\x06 \x01(\bR\bdisabled\x12 \n" +
+	"\tmax_value\x18\a \x01(\x03H\x02R\bmaxValue\x88\x01\x01\x12 \n" +
+	"\tmin_value\x18\b \x01(\x03H\x03R\bminValue\x88\x01\x01\x12\x17\n" +
+	"\x04step\x18\t \x01(\x03H\x04R\x04step\x88\x01\x01B\b\n" +
+	"\x06_valueB\x10\n" +
+	"\x0e_default_valueB\f\n" +
+	"\n" +
+	"_max_valueB\f\n" +
+	"\n" +
+	"_min_valueB\a\n" +
+	"\x05_step\"S\n" +
+	"\x04Json\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12&\n" +
+	"\fexpand_depth\x18\x02 \x01(\x05H\x00R\vexpandDepth\x88\x01\x01B\x0f\n" +
+	"\r_expand_depth\"\x1e\n" +
 	"\bMarkdown\x12\x12\n" +
-	"\x04body\x18\x01 \x01(\tR\x04body\"\xd2\x01\n" +
+	"\x04body\x18\x01 \x01(\tR\x04body\"\xe0\x01\n" +
+	"\x06Metric\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12'\n" +
+	"\x0fformatted_value\x18\x03 \x01(\tR\x0eformattedValue\x12\x19\n" +
+	"\x05delta\x18\x04 \x01(\x01H\x00R\x05delta\x88\x01\x01\x12'\n" +
+	"\x0fformatted_delta\x18\x05 \x01(\tR\x0eformattedDelta\x12\x1f\n" +
+	"\vdelta_color\x18\x06 \x01(\tR\n" +
+	"deltaColor\x12\x12\n" +
+	"\x04help\x18\a \x01(\tR\x04helpB\b\n" +
+	"\x06_delta\"\x81\x03\n" +
 	"\vMultiSelect\x12\x14\n" +
 	"\x05value\x18\x01 \x03(\x05R\x05value\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x18\n" +
@@ -1924,7 +4195,15 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\vplaceholder\x18\x04 \x01(\tR\vplaceholder\x12#\n" +
 	"\rdefault_value\x18\x05 \x03(\x05R\fdefaultValue\x12\x1a\n" +
 	"\brequired\x18\x06 \x01(\bR\brequired\x12\x1a\n" +
-	"\bdisabled\x18\a \x01(\bR\bdisabled\"\xbe\x02\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabled\x12\x1e\n" +
+	"\n" +
+	"searchable\x18\b \x01(\bR\n" +
+	"searchable\x12#\n" +
+	"\rsearch_values\x18\t \x03(\tR\fsearchValues\x12#\n" +
+	"\rsearch_labels\x18\n" +
+	" \x03(\tR\fsearchLabels\x12\x1c\n" +
+	"\tcreatable\x18\v \x01(\bR\tcreatable\x12%\n" +
+	"\x0ecreated_values\x18\f \x03(\tR\rcreatedValues\"\x91\x03\n" +
 	"\vNumberInput\x12\x19\n" +
 	"\x05value\x18\x01 \x01(\x01H\x00R\x05value\x88\x01\x01\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12 \n" +
@@ -1933,13 +4212,23 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\brequired\x18\x05 \x01(\bR\brequired\x12\x1a\n" +
 	"\bdisabled\x18\x06 \x01(\bR\bdisabled\x12 \n" +
 	"\tmax_value\x18\a \x01(\x01H\x02R\bmaxValue\x88\x01\x01\x12 \n" +
-	"\tmin_value\x18\b \x01(\x01H\x03R\bminValue\x88\x01\x01B\b\n" +
+	"\tmin_value\x18\b \x01(\x01H\x03R\bminValue\x88\x01\x01\x12\x17\n" +
+	"\x04step\x18\t \x01(\x01H\x04R\x04step\x88\x01\x01\x12!\n" +
+	"\tprecision\x18\n" +
+	" \x01(\x05H\x05R\tprecision\x88\x01\x01B\b\n" +
 	"\x06_valueB\x10\n" +
 	"\x0e_default_valueB\f\n" +
 	"\n" +
 	"_max_valueB\f\n" +
 	"\n" +
-	"_min_value\"\xd0\x01\n" +
+	"_min_valueB\a\n" +
+	"\x05_stepB\f\n" +
+	"\n" +
+	"_precision\"J\n" +
+	"\bProgress\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"\xd0\x01\n" +
 	"\x05Radio\x12\x19\n" +
 	"\x05value\x18\x01 \x01(\x05H\x00R\x05value\x88\x01\x01\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x18\n" +
@@ -1948,7 +4237,27 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\brequired\x18\x05 \x01(\bR\brequired\x12\x1a\n" +
 	"\bdisabled\x18\x06 \x01(\bR\bdisabledB\b\n" +
 	"\x06_valueB\x10\n" +
-	"\x0e_default_value\"\xf6\x01\n" +
+	"\x0e_default_value\"\xbb\x02\n" +
+	"\vRangeSlider\x12\x1b\n" +
+	"\tlow_value\x18\x01 \x01(\x01R\blowValue\x12\x1d\n" +
+	"\n" +
+	"high_value\x18\x02 \x01(\x01R\thighValue\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12*\n" +
+	"\x11default_low_value\x18\x04 \x01(\x01R\x0fdefaultLowValue\x12,\n" +
+	"\x12default_high_value\x18\x05 \x01(\x01R\x10defaultHighValue\x12\x1b\n" +
+	"\tmin_value\x18\x06 \x01(\x01R\bminValue\x12\x1b\n" +
+	"\tmax_value\x18\a \x01(\x01R\bmaxValue\x12\x12\n" +
+	"\x04step\x18\b \x01(\x01R\x04step\x12\x16\n" +
+	"\x06format\x18\t \x01(\tR\x06format\x12\x1a\n" +
+	"\bdisabled\x18\n" +
+	" \x01(\bR\bdisabled\"\xae\x01\n" +
+	"\x06Rating\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x05R\x05value\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x1b\n" +
+	"\tmax_value\x18\x03 \x01(\x05R\bmaxValue\x12#\n" +
+	"\rdefault_value\x18\x04 \x01(\x05R\fdefaultValue\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12\x1a\n" +
+	"\bdisabled\x18\x06 \x01(\bR\bdisabled\"\x88\x03\n" +
 	"\tSelectbox\x12\x19\n" +
 	"\x05value\x18\x01 \x01(\x05H\x00R\x05value\x88\x01\x01\x12\x14\n" +
 	"\x05label\x18\x02 \x01(\tR\x05label\x12\x18\n" +
@@ -1956,9 +4265,29 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\vplaceholder\x18\x04 \x01(\tR\vplaceholder\x12(\n" +
 	"\rdefault_value\x18\x05 \x01(\x05H\x01R\fdefaultValue\x88\x01\x01\x12\x1a\n" +
 	"\brequired\x18\x06 \x01(\bR\brequired\x12\x1a\n" +
-	"\bdisabled\x18\a \x01(\bR\bdisabledB\b\n" +
+	"\bdisabled\x18\a \x01(\bR\bdisabled\x12\x1e\n" +
+	"\n" +
+	"searchable\x18\b \x01(\bR\n" +
+	"searchable\x12&\n" +
+	"\fsearch_value\x18\t \x01(\tH\x02R\vsearchValue\x88\x01\x01\x12&\n" +
+	"\fsearch_label\x18\n" +
+	" \x01(\tH\x03R\vsearchLabel\x88\x01\x01B\b\n" +
 	"\x06_valueB\x10\n" +
-	"\x0e_default_value\"\x8f\x02\n" +
+	"\x0e_default_valueB\x0f\n" +
+	"\r_search_valueB\x0f\n" +
+	"\r_search_label\"\xdb\x01\n" +
+	"\x06Slider\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\x01R\x05value\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12#\n" +
+	"\rdefault_value\x18\x03 \x01(\x01R\fdefaultValue\x12\x1b\n" +
+	"\tmin_value\x18\x04 \x01(\x01R\bminValue\x12\x1b\n" +
+	"\tmax_value\x18\x05 \x01(\x01R\bmaxValue\x12\x12\n" +
+	"\x04step\x18\x06 \x01(\x01R\x04step\x12\x16\n" +
+	"\x06format\x18\a \x01(\tR\x06format\x12\x1a\n" +
+	"\bdisabled\x18\b \x01(\bR\bdisabled\"3\n" +
+	"\aSpinner\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x12\n" +
+	"\x04done\x18\x02 \x01(\bR\x04done\"\x8f\x02\n" +
 	"\x05Table\x12\x12\n" +
 	"\x04data\x18\x01 \x01(\fR\x04data\x12+\n" +
 	"\x05value\x18\x02 \x01(\v2\x15.widget.v1.TableValueR\x05value\x12\x16\n" +
@@ -2024,7 +4353,27 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\brequired\x18\x05 \x01(\bR\brequired\x12\x1a\n" +
 	"\bdisabled\x18\x06 \x01(\bR\bdisabledB\b\n" +
 	"\x06_valueB\x10\n" +
-	"\x0e_default_value\"\xa8\a\n" +
+	"\x0e_default_value\"u\n" +
+	"\x06Toggle\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\bR\x05value\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12#\n" +
+	"\rdefault_value\x18\x03 \x01(\bR\fdefaultValue\x12\x1a\n" +
+	"\bdisabled\x18\x04 \x01(\bR\bdisabled\"c\n" +
+	"\fUploadedFile\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\"\xd6\x01\n" +
+	"\x05Video\x12\x10\n" +
+	"\x03src\x18\x01 \x01(\tR\x03src\x12\x19\n" +
+	"\bmedia_id\x18\x02 \x01(\tR\amediaId\x12\x1b\n" +
+	"\tmime_type\x18\x03 \x01(\tR\bmimeType\x12\x18\n" +
+	"\acaption\x18\x04 \x01(\tR\acaption\x12\x19\n" +
+	"\x05width\x18\x05 \x01(\x05H\x00R\x05width\x88\x01\x01\x12\x1a\n" +
+	"\bautoplay\x18\x06 \x01(\bR\bautoplay\x12\x12\n" +
+	"\x04loop\x18\a \x01(\bR\x04loop\x12\x14\n" +
+	"\x05muted\x18\b \x01(\bR\x05mutedB\b\n" +
+	"\x06_width\"\xfa\x0e\n" +
 	"\x06Widget\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
 	"\x06button\x18\x02 \x01(\v2\x11.widget.v1.ButtonH\x00R\x06button\x121\n" +
@@ -2048,7 +4397,26 @@ const file_widget_v1_widget_proto_rawDesc = "" +
 	"\n" +
 	"text_input\x18\x11 \x01(\v2\x14.widget.v1.TextInputH\x00R\ttextInput\x125\n" +
 	"\n" +
-	"time_input\x18\x12 \x01(\v2\x14.widget.v1.TimeInputH\x00R\ttimeInputB\x06\n" +
+	"time_input\x18\x12 \x01(\v2\x14.widget.v1.TimeInputH\x00R\ttimeInput\x12(\n" +
+	"\x05alert\x18\x13 \x01(\v2\x10.widget.v1.AlertH\x00R\x05alert\x12+\n" +
+	"\x06metric\x18\x14 \x01(\v2\x11.widget.v1.MetricH\x00R\x06metric\x121\n" +
+	"\bprogress\x18\x15 \x01(\v2\x13.widget.v1.ProgressH\x00R\bprogress\x12.\n" +
+	"\aspinner\x18\x16 \x01(\v2\x12.widget.v1.SpinnerH\x00R\aspinner\x12>\n" +
+	"\rfile_uploader\x18\x17 \x01(\v2\x17.widget.v1.FileUploaderH\x00R\ffileUploader\x12D\n" +
+	"\x0fdownload_button\x18\x18 \x01(\v2\x19.widget.v1.DownloadButtonH\x00R\x0edownloadButton\x12(\n" +
+	"\x05image\x18\x19 \x01(\v2\x10.widget.v1.ImageH\x00R\x05image\x12(\n" +
+	"\x05video\x18\x1a \x01(\v2\x10.widget.v1.VideoH\x00R\x05video\x12(\n" +
+	"\x05audio\x18\x1b \x01(\v2\x10.widget.v1.AudioH\x00R\x05audio\x12%\n" +
+	"\x04code\x18\x1c \x01(\v2\x0f.widget.v1.CodeH\x00R\x04code\x12%\n" +
+	"\x04json\x18\x1d \x01(\v2\x0f.widget.v1.JsonH\x00R\x04json\x12+\n" +
+	"\x06slider\x18\x1e \x01(\v2\x11.widget.v1.SliderH\x00R\x06slider\x12;\n" +
+	"\frange_slider\x18\x1f \x01(\v2\x16.widget.v1.RangeSliderH\x00R\vrangeSlider\x12H\n" +
+	"\x11date_range_slider\x18  \x01(\v2\x1a.widget.v1.DateRangeSliderH\x00R\x0fdateRangeSlider\x12E\n" +
+	"\x10date_range_input\x18! \x01(\v2\x19.widget.v1.DateRangeInputH\x00R\x0edateRangeInput\x12+\n" +
+	"\x06toggle\x18\" \x01(\v2\x11.widget.v1.ToggleH\x00R\x06toggle\x12;\n" +
+	"\fcolor_picker\x18# \x01(\v2\x16.widget.v1.ColorPickerH\x00R\vcolorPicker\x12+\n" +
+	"\x06rating\x18$ \x01(\v2\x11.widget.v1.RatingH\x00R\x06rating\x122\n" +
+	"\tint_input\x18% \x01(\v2\x13.widget.v1.IntInputH\x00R\bintInputB\x06\n" +
 	"\x04typeB\xa8\x01\n" +
 	"\rcom.widget.v1B\vWidgetProtoP\x01ZEgithub.com/trysourcetool/sourcetool-go/internal/pb/widget/v1;widgetv1\xa2\x02\x03WXX\xaa\x02\tWidget.V1\xca\x02\tWidget\\V1\xe2\x02\x15Widget\\V1\\GPBMetadata\xea\x02\n" +
 	"Widget::V1b\x06proto3"
@@ -2065,54 +4433,96 @@ func file_widget_v1_widget_proto_rawDescGZIP() []byte {
 	return file_widget_v1_widget_proto_rawDescData
 }
 
-var file_widget_v1_widget_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_widget_v1_widget_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_widget_v1_widget_proto_goTypes = []any{
-	(*Button)(nil),              // 0: widget.v1.Button
-	(*Checkbox)(nil),            // 1: widget.v1.Checkbox
-	(*CheckboxGroup)(nil),       // 2: widget.v1.CheckboxGroup
-	(*ColumnItem)(nil),          // 3: widget.v1.ColumnItem
-	(*Columns)(nil),             // 4: widget.v1.Columns
-	(*DateInput)(nil),           // 5: widget.v1.DateInput
-	(*DateTimeInput)(nil),       // 6: widget.v1.DateTimeInput
-	(*Form)(nil),                // 7: widget.v1.Form
-	(*Markdown)(nil),            // 8: widget.v1.Markdown
-	(*MultiSelect)(nil),         // 9: widget.v1.MultiSelect
-	(*NumberInput)(nil),         // 10: widget.v1.NumberInput
-	(*Radio)(nil),               // 11: widget.v1.Radio
-	(*Selectbox)(nil),           // 12: widget.v1.Selectbox
-	(*Table)(nil),               // 13: widget.v1.Table
-	(*TableValue)(nil),          // 14: widget.v1.TableValue
-	(*TableValueSelection)(nil), // 15: widget.v1.TableValueSelection
-	(*TextArea)(nil),            // 16: widget.v1.TextArea
-	(*TextInput)(nil),           // 17: widget.v1.TextInput
-	(*TimeInput)(nil),           // 18: widget.v1.TimeInput
-	(*Widget)(nil),              // 19: widget.v1.Widget
+	(*Alert)(nil),                // 0: widget.v1.Alert
+	(*Audio)(nil),                // 1: widget.v1.Audio
+	(*Button)(nil),               // 2: widget.v1.Button
+	(*Checkbox)(nil),             // 3: widget.v1.Checkbox
+	(*CheckboxGroup)(nil),        // 4: widget.v1.CheckboxGroup
+	(*Code)(nil),                 // 5: widget.v1.Code
+	(*ColorPicker)(nil),          // 6: widget.v1.ColorPicker
+	(*ColumnItem)(nil),           // 7: widget.v1.ColumnItem
+	(*Columns)(nil),              // 8: widget.v1.Columns
+	(*DateInput)(nil),            // 9: widget.v1.DateInput
+	(*DateRangeInput)(nil),       // 10: widget.v1.DateRangeInput
+	(*DateRangeInputPreset)(nil), // 11: widget.v1.DateRangeInputPreset
+	(*DateRangeSlider)(nil),      // 12: widget.v1.DateRangeSlider
+	(*DateTimeInput)(nil),        // 13: widget.v1.DateTimeInput
+	(*DownloadButton)(nil),       // 14: widget.v1.DownloadButton
+	(*FileUploader)(nil),         // 15: widget.v1.FileUploader
+	(*Form)(nil),                 // 16: widget.v1.Form
+	(*Image)(nil),                // 17: widget.v1.Image
+	(*IntInput)(nil),             // 18: widget.v1.IntInput
+	(*Json)(nil),                 // 19: widget.v1.Json
+	(*Markdown)(nil),             // 20: widget.v1.Markdown
+	(*Metric)(nil),               // 21: widget.v1.Metric
+	(*MultiSelect)(nil),          // 22: widget.v1.MultiSelect
+	(*NumberInput)(nil),          // 23: widget.v1.NumberInput
+	(*Progress)(nil),             // 24: widget.v1.Progress
+	(*Radio)(nil),                // 25: widget.v1.Radio
+	(*RangeSlider)(nil),          // 26: widget.v1.RangeSlider
+	(*Rating)(nil),               // 27: widget.v1.Rating
+	(*Selectbox)(nil),            // 28: widget.v1.Selectbox
+	(*Slider)(nil),               // 29: widget.v1.Slider
+	(*Spinner)(nil),              // 30: widget.v1.Spinner
+	(*Table)(nil),                // 31: widget.v1.Table
+	(*TableValue)(nil),           // 32: widget.v1.TableValue
+	(*TableValueSelection)(nil),  // 33: widget.v1.TableValueSelection
+	(*TextArea)(nil),             // 34: widget.v1.TextArea
+	(*TextInput)(nil),            // 35: widget.v1.TextInput
+	(*TimeInput)(nil),            // 36: widget.v1.TimeInput
+	(*Toggle)(nil),               // 37: widget.v1.Toggle
+	(*UploadedFile)(nil),         // 38: widget.v1.UploadedFile
+	(*Video)(nil),                // 39: widget.v1.Video
+	(*Widget)(nil),               // 40: widget.v1.Widget
 }
 var file_widget_v1_widget_proto_depIdxs = []int32{
-	14, // 0: widget.v1.Table.value:type_name -> widget.v1.TableValue
-	15, // 1: widget.v1.TableValue.selection:type_name -> widget.v1.TableValueSelection
-	0,  // 2: widget.v1.Widget.button:type_name -> widget.v1.Button
-	1,  // 3: widget.v1.Widget.checkbox:type_name -> widget.v1.Checkbox
-	2,  // 4: widget.v1.Widget.checkbox_group:type_name -> widget.v1.CheckboxGroup
-	3,  // 5: widget.v1.Widget.column_item:type_name -> widget.v1.ColumnItem
-	4,  // 6: widget.v1.Widget.columns:type_name -> widget.v1.Columns
-	5,  // 7: widget.v1.Widget.date_input:type_name -> widget.v1.DateInput
-	6,  // 8: widget.v1.Widget.date_time_input:type_name -> widget.v1.DateTimeInput
-	7,  // 9: widget.v1.Widget.form:type_name -> widget.v1.Form
-	8,  // 10: widget.v1.Widget.markdown:type_name -> widget.v1.Markdown
-	9,  // 11: widget.v1.Widget.multi_select:type_name -> widget.v1.MultiSelect
-	10, // 12: widget.v1.Widget.number_input:type_name -> widget.v1.NumberInput
-	11, // 13: widget.v1.Widget.radio:type_name -> widget.v1.Radio
-	12, // 14: widget.v1.Widget.selectbox:type_name -> widget.v1.Selectbox
-	13, // 15: widget.v1.Widget.table:type_name -> widget.v1.Table
-	16, // 16: widget.v1.Widget.text_area:type_name -> widget.v1.TextArea
-	17, // 17: widget.v1.Widget.text_input:type_name -> widget.v1.TextInput
-	18, // 18: widget.v1.Widget.time_input:type_name -> widget.v1.TimeInput
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	11, // 0: widget.v1.DateRangeInput.presets:type_name -> widget.v1.DateRangeInputPreset
+	38, // 1: widget.v1.FileUploader.value:type_name -> widget.v1.UploadedFile
+	32, // 2: widget.v1.Table.value:type_name -> widget.v1.TableValue
+	33, // 3: widget.v1.TableValue.selection:type_name -> widget.v1.TableValueSelection
+	2,  // 4: widget.v1.Widget.button:type_name -> widget.v1.Button
+	3,  // 5: widget.v1.Widget.checkbox:type_name -> widget.v1.Checkbox
+	4,  // 6: widget.v1.Widget.checkbox_group:type_name -> widget.v1.CheckboxGroup
+	7,  // 7: widget.v1.Widget.column_item:type_name -> widget.v1.ColumnItem
+	8,  // 8: widget.v1.Widget.columns:type_name -> widget.v1.Columns
+	9,  // 9: widget.v1.Widget.date_input:type_name -> widget.v1.DateInput
+	13, // 10: widget.v1.Widget.date_time_input:type_name -> widget.v1.DateTimeInput
+	16, // 11: widget.v1.Widget.form:type_name -> widget.v1.Form
+	20, // 12: widget.v1.Widget.markdown:type_name -> widget.v1.Markdown
+	22, // 13: widget.v1.Widget.multi_select:type_name -> widget.v1.MultiSelect
+	23, // 14: widget.v1.Widget.number_input:type_name -> widget.v1.NumberInput
+	25, // 15: widget.v1.Widget.radio:type_name -> widget.v1.Radio
+	28, // 16: widget.v1.Widget.selectbox:type_name -> widget.v1.Selectbox
+	31, // 17: widget.v1.Widget.table:type_name -> widget.v1.Table
+	34, // 18: widget.v1.Widget.text_area:type_name -> widget.v1.TextArea
+	35, // 19: widget.v1.Widget.text_input:type_name -> widget.v1.TextInput
+	36, // 20: widget.v1.Widget.time_input:type_name -> widget.v1.TimeInput
+	0,  // 21: widget.v1.Widget.alert:type_name -> widget.v1.Alert
+	21, // 22: widget.v1.Widget.metric:type_name -> widget.v1.Metric
+	24, // 23: widget.v1.Widget.progress:type_name -> widget.v1.Progress
+	30, // 24: widget.v1.Widget.spinner:type_name -> widget.v1.Spinner
+	15, // 25: widget.v1.Widget.file_uploader:type_name -> widget.v1.FileUploader
+	14, // 26: widget.v1.Widget.download_button:type_name -> widget.v1.DownloadButton
+	17, // 27: widget.v1.Widget.image:type_name -> widget.v1.Image
+	39, // 28: widget.v1.Widget.video:type_name -> widget.v1.Video
+	1,  // 29: widget.v1.Widget.audio:type_name -> widget.v1.Audio
+	5,  // 30: widget.v1.Widget.code:type_name -> widget.v1.Code
+	19, // 31: widget.v1.Widget.json:type_name -> widget.v1.Json
+	29, // 32: widget.v1.Widget.slider:type_name -> widget.v1.Slider
+	26, // 33: widget.v1.Widget.range_slider:type_name -> widget.v1.RangeSlider
+	12, // 34: widget.v1.Widget.date_range_slider:type_name -> widget.v1.DateRangeSlider
+	10, // 35: widget.v1.Widget.date_range_input:type_name -> widget.v1.DateRangeInput
+	37, // 36: widget.v1.Widget.toggle:type_name -> widget.v1.Toggle
+	6,  // 37: widget.v1.Widget.color_picker:type_name -> widget.v1.ColorPicker
+	27, // 38: widget.v1.Widget.rating:type_name -> widget.v1.Rating
+	18, // 39: widget.v1.Widget.int_input:type_name -> widget.v1.IntInput
+	40, // [40:40] is the sub-list for method output_type
+	40, // [40:40] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_widget_v1_widget_proto_init() }
@@ -2120,17 +4530,24 @@ func file_widget_v1_widget_proto_init() {
 	if File_widget_v1_widget_proto != nil {
 		return
 	}
-	file_widget_v1_widget_proto_msgTypes[5].OneofWrappers = []any{}
-	file_widget_v1_widget_proto_msgTypes[6].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[9].OneofWrappers = []any{}
 	file_widget_v1_widget_proto_msgTypes[10].OneofWrappers = []any{}
-	file_widget_v1_widget_proto_msgTypes[11].OneofWrappers = []any{}
-	file_widget_v1_widget_proto_msgTypes[12].OneofWrappers = []any{}
 	file_widget_v1_widget_proto_msgTypes[13].OneofWrappers = []any{}
-	file_widget_v1_widget_proto_msgTypes[14].OneofWrappers = []any{}
-	file_widget_v1_widget_proto_msgTypes[16].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[15].OneofWrappers = []any{}
 	file_widget_v1_widget_proto_msgTypes[17].OneofWrappers = []any{}
 	file_widget_v1_widget_proto_msgTypes[18].OneofWrappers = []any{}
-	file_widget_v1_widget_proto_msgTypes[19].OneofWrappers = []any{
+	file_widget_v1_widget_proto_msgTypes[19].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[21].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[23].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[25].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[28].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[31].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[32].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[34].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[35].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[36].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[39].OneofWrappers = []any{}
+	file_widget_v1_widget_proto_msgTypes[40].OneofWrappers = []any{
 		(*Widget_Button)(nil),
 		(*Widget_Checkbox)(nil),
 		(*Widget_CheckboxGroup)(nil),
@@ -2148,6 +4565,25 @@ func file_widget_v1_widget_proto_init() {
 		(*Widget_TextArea)(nil),
 		(*Widget_TextInput)(nil),
 		(*Widget_TimeInput)(nil),
+		(*Widget_Alert)(nil),
+		(*Widget_Metric)(nil),
+		(*Widget_Progress)(nil),
+		(*Widget_Spinner)(nil),
+		(*Widget_FileUploader)(nil),
+		(*Widget_DownloadButton)(nil),
+		(*Widget_Image)(nil),
+		(*Widget_Video)(nil),
+		(*Widget_Audio)(nil),
+		(*Widget_Code)(nil),
+		(*Widget_Json)(nil),
+		(*Widget_Slider)(nil),
+		(*Widget_RangeSlider)(nil),
+		(*Widget_DateRangeSlider)(nil),
+		(*Widget_DateRangeInput)(nil),
+		(*Widget_Toggle)(nil),
+		(*Widget_ColorPicker)(nil),
+		(*Widget_Rating)(nil),
+		(*Widget_IntInput)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -2155,7 +4591,7 @@ func file_widget_v1_widget_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_widget_v1_widget_proto_rawDesc), len(file_widget_v1_widget_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
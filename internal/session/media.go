@@ -0,0 +1,28 @@
+package session
+
+import "sync"
+
+// Media tracks inline media already streamed to the browser for a session,
+// so reruns reference it by ID instead of sending the bytes again.
+type Media struct {
+	sent map[string]struct{}
+	mu   sync.Mutex
+}
+
+func newMedia() *Media {
+	return &Media{
+		sent: make(map[string]struct{}),
+	}
+}
+
+// MarkSent records id as sent and reports whether it had not been sent yet.
+func (m *Media) MarkSent(id string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.sent[id]; ok {
+		return false
+	}
+	m.sent[id] = struct{}{}
+	return true
+}
@@ -0,0 +1,137 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+// SearchFunc answers a query with matching values and their display labels.
+// An empty label is replaced with the formatted value.
+type SearchFunc func(ctx context.Context, query string) (values, labels []string, err error)
+
+// maxKnownSearchValues caps how many searched values each widget remembers.
+// The oldest values are forgotten first.
+const maxKnownSearchValues = 1000
+
+type searcher struct {
+	search SearchFunc
+	format func(string) string
+	known  map[string]string
+	order  []string
+	cancel context.CancelFunc
+}
+
+func (sr *searcher) remember(value, label string) {
+	if _, ok := sr.known[value]; !ok {
+		sr.order = append(sr.order, value)
+	}
+	sr.known[value] = label
+	for len(sr.order) > maxKnownSearchValues {
+		delete(sr.known, sr.order[0])
+		sr.order = sr.order[1:]
+	}
+}
+
+// Searches holds the search callbacks of searchable widgets so queries can be
+// answered without rerunning the page. It also remembers the values a search
+// returned, which is what a client is allowed to select.
+type Searches struct {
+	searchers map[uuid.UUID]*searcher
+	mu        sync.Mutex
+}
+
+func newSearches() *Searches {
+	return &Searches{
+		searchers: make(map[uuid.UUID]*searcher),
+	}
+}
+
+func (s *Searches) Register(widgetID uuid.UUID, search SearchFunc, format func(string) string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sr, ok := s.searchers[widgetID]; ok {
+		sr.search = search
+		sr.format = format
+		return
+	}
+	s.searchers[widgetID] = &searcher{
+		search: search,
+		format: format,
+		known:  make(map[string]string),
+	}
+}
+
+// Search runs the callback registered for widgetID and returns the matching
+// values along with their display labels. A new search of the same widget
+// cancels the context of the one still running.
+func (s *Searches) Search(ctx context.Context, widgetID uuid.UUID, query string) ([]string, []string, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s.mu.Lock()
+	sr, ok := s.searchers[widgetID]
+	if !ok {
+		s.mu.Unlock()
+		return nil, nil, fmt.Errorf("widget is not searchable: %s", widgetID)
+	}
+	if sr.cancel != nil {
+		sr.cancel()
+	}
+	sr.cancel = cancel
+	search, format := sr.search, sr.format
+	s.mu.Unlock()
+
+	values, labels, err := search(ctx, query)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	resultLabels := make([]string, len(values))
+	for i, v := range values {
+		if i < len(labels) && labels[i] != "" {
+			resultLabels[i] = labels[i]
+		} else {
+			resultLabels[i] = format(v)
+		}
+	}
+
+	s.mu.Lock()
+	for i, v := range values {
+		sr.remember(v, resultLabels[i])
+	}
+	s.mu.Unlock()
+
+	return values, resultLabels, nil
+}
+
+// Label returns the label of v if it was returned by a search of widgetID.
+func (s *Searches) Label(widgetID uuid.UUID, v string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sr, ok := s.searchers[widgetID]
+	if !ok {
+		return "", false
+	}
+	label, ok := sr.known[v]
+	return label, ok
+}
+
+// Reset forgets every searcher and cancels the searches still running.
+func (s *Searches) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sr := range s.searchers {
+		if sr.cancel != nil {
+			sr.cancel()
+		}
+	}
+	s.searchers = make(map[uuid.UUID]*searcher)
+}
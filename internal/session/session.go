@@ -1,6 +1,7 @@
 package session
 
 import (
+	"net/url"
 	"sync"
 	"time"
 
@@ -20,16 +21,39 @@ type disconnectedSession struct {
 }
 
 type Session struct {
-	ID     uuid.UUID
-	PageID uuid.UUID
-	State  *State
+	ID       uuid.UUID
+	PageID   uuid.UUID
+	State    *State
+	Uploads  *Uploads
+	Media    *Media
+	Searches *Searches
+	// Params holds the route parameters matched for the current page run.
+	Params map[string]string
+	// QueryParams holds the query string of the page URL.
+	QueryParams url.Values
+	// UserGroups holds the groups of the user connected to the session.
+	UserGroups []string
+	// PendingNavigation holds a navigation requested during the current run.
+	// The runtime applies it once the page handler returns.
+	PendingNavigation *Navigation
+}
+
+// Navigation describes a switch to another page requested by a handler.
+type Navigation struct {
+	PageID    uuid.UUID
+	Params    map[string]string
+	URLPath   string
+	KeepState bool
 }
 
 func New(id, pageID uuid.UUID) *Session {
 	return &Session{
-		ID:     id,
-		PageID: pageID,
-		State:  newState(),
+		ID:       id,
+		PageID:   pageID,
+		State:    newState(),
+		Uploads:  newUploads(),
+		Media:    newMedia(),
+		Searches: newSearches(),
 	}
 }
 
@@ -76,6 +100,8 @@ func (s *SessionManager) SetSession(session *Session) {
 
 	if ds, ok := s.disconnectedSessions[session.ID]; ok {
 		session.State = ds.session.State
+		session.Uploads = ds.session.Uploads
+		session.Searches = ds.session.Searches
 		delete(s.disconnectedSessions, session.ID)
 	}
 
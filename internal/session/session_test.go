@@ -1,6 +1,10 @@
 package session
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"sync"
 	"testing"
 
@@ -201,3 +205,228 @@ func TestState_ConcurrentAccess(t *testing.T) {
 
 	wg.Wait()
 }
+
+func TestUploads_Append(t *testing.T) {
+	uploads := newUploads()
+	widgetID := uuid.Must(uuid.NewV4())
+	fileID := uuid.Must(uuid.NewV4())
+
+	uploads.Start(&Upload{
+		ID:       fileID,
+		WidgetID: widgetID,
+		Name:     "data.bin",
+		Size:     6,
+	})
+
+	if err := uploads.Append(fileID, 3, []byte("abc"), false); err == nil {
+		t.Error("Append with wrong offset returned nil error")
+	}
+	if err := uploads.Append(fileID, 0, []byte("abc"), false); err != nil {
+		t.Fatalf("Append returned error: %v", err)
+	}
+	if uploads.Get(fileID).Complete() {
+		t.Error("upload complete after first chunk")
+	}
+	if err := uploads.Append(fileID, 3, []byte("defg"), true); err == nil {
+		t.Error("Append beyond declared size returned nil error")
+	}
+	if err := uploads.Append(fileID, 3, []byte("def"), true); err != nil {
+		t.Fatalf("Append returned error: %v", err)
+	}
+
+	upload := uploads.Get(fileID)
+	if !upload.Complete() {
+		t.Error("upload not complete after last chunk")
+	}
+	if got := string(upload.Bytes()); got != "abcdef" {
+		t.Errorf("Bytes() = %q, want %q", got, "abcdef")
+	}
+	if err := uploads.Append(fileID, 6, []byte("x"), true); err == nil {
+		t.Error("Append after completion returned nil error")
+	}
+}
+
+func TestUploads_Retain(t *testing.T) {
+	uploads := newUploads()
+	widgetID := uuid.Must(uuid.NewV4())
+	otherWidgetID := uuid.Must(uuid.NewV4())
+	keepID := uuid.Must(uuid.NewV4())
+	dropID := uuid.Must(uuid.NewV4())
+	pendingID := uuid.Must(uuid.NewV4())
+	otherID := uuid.Must(uuid.NewV4())
+
+	uploads.Start(&Upload{ID: keepID, WidgetID: widgetID})
+	uploads.Start(&Upload{ID: dropID, WidgetID: widgetID})
+	uploads.Start(&Upload{ID: pendingID, WidgetID: widgetID, Size: 1})
+	uploads.Start(&Upload{ID: otherID, WidgetID: otherWidgetID})
+	for _, id := range []uuid.UUID{keepID, dropID, otherID} {
+		if err := uploads.Append(id, 0, nil, true); err != nil {
+			t.Fatalf("Append returned error: %v", err)
+		}
+	}
+
+	uploads.Retain(widgetID, []uuid.UUID{keepID})
+
+	if uploads.Get(keepID) == nil {
+		t.Error("retained upload was removed")
+	}
+	if uploads.Get(dropID) != nil {
+		t.Error("unlisted upload was not removed")
+	}
+	if uploads.Get(pendingID) == nil {
+		t.Error("upload in progress was removed")
+	}
+	if uploads.Get(otherID) == nil {
+		t.Error("upload of another widget was removed")
+	}
+}
+
+func TestUploads_Replace(t *testing.T) {
+	uploads := newUploads()
+	widgetID := uuid.Must(uuid.NewV4())
+	otherWidgetID := uuid.Must(uuid.NewV4())
+	completeID := uuid.Must(uuid.NewV4())
+	pendingID := uuid.Must(uuid.NewV4())
+	otherID := uuid.Must(uuid.NewV4())
+	newID := uuid.Must(uuid.NewV4())
+
+	uploads.Start(&Upload{ID: completeID, WidgetID: widgetID})
+	if err := uploads.Append(completeID, 0, nil, true); err != nil {
+		t.Fatalf("Append returned error: %v", err)
+	}
+	uploads.Start(&Upload{ID: pendingID, WidgetID: widgetID, Size: 1})
+	uploads.Start(&Upload{ID: otherID, WidgetID: otherWidgetID})
+
+	uploads.Replace(&Upload{ID: newID, WidgetID: widgetID})
+
+	if uploads.Get(newID) == nil {
+		t.Error("replacing upload was not started")
+	}
+	if uploads.Get(completeID) != nil {
+		t.Error("completed upload was not replaced")
+	}
+	if uploads.Get(pendingID) != nil {
+		t.Error("upload in progress was not replaced")
+	}
+	if uploads.Get(otherID) == nil {
+		t.Error("upload of another widget was removed")
+	}
+}
+
+func TestSearches_Search(t *testing.T) {
+	searches := newSearches()
+	widgetID := uuid.Must(uuid.NewV4())
+	customers := []string{"acme", "globex", "initech"}
+
+	searches.Register(widgetID, func(ctx context.Context, query string) ([]string, []string, error) {
+		if query == "fail" {
+			return nil, nil, errors.New("backend unavailable")
+		}
+		var values, labels []string
+		for _, c := range customers {
+			if strings.Contains(c, query) {
+				values = append(values, c)
+				labels = append(labels, "")
+			}
+		}
+		if query == "i" {
+			labels[0] = "Initech Corp."
+		}
+		return values, labels, nil
+	}, strings.ToUpper)
+
+	values, labels, err := searches.Search(context.Background(), widgetID, "ex")
+	if err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+	if len(values) != 1 || values[0] != "globex" {
+		t.Errorf("values = %v, want [globex]", values)
+	}
+	if len(labels) != 1 || labels[0] != "GLOBEX" {
+		t.Errorf("labels = %v, want [GLOBEX]", labels)
+	}
+
+	if _, labels, _ := searches.Search(context.Background(), widgetID, "i"); len(labels) != 1 || labels[0] != "Initech Corp." {
+		t.Errorf("labels = %v, want [Initech Corp.]", labels)
+	}
+
+	if label, ok := searches.Label(widgetID, "globex"); !ok || label != "GLOBEX" {
+		t.Errorf("Label(globex) = (%q, %v), want (GLOBEX, true)", label, ok)
+	}
+	if label, ok := searches.Label(widgetID, "initech"); !ok || label != "Initech Corp." {
+		t.Errorf("Label(initech) = (%q, %v), want (Initech Corp., true)", label, ok)
+	}
+	if _, ok := searches.Label(widgetID, "acme"); ok {
+		t.Error("Label(acme) ok = true, want false for a value never returned")
+	}
+
+	if _, _, err := searches.Search(context.Background(), widgetID, "fail"); err == nil {
+		t.Error("Search with failing callback returned nil error")
+	}
+	if _, _, err := searches.Search(context.Background(), uuid.Must(uuid.NewV4()), "ex"); err == nil {
+		t.Error("Search of unregistered widget returned nil error")
+	}
+
+	searches.Reset()
+	if _, ok := searches.Label(widgetID, "globex"); ok {
+		t.Error("Label(globex) after Reset ok = true, want false")
+	}
+}
+
+func TestSearches_KnownValuesCapped(t *testing.T) {
+	searches := newSearches()
+	widgetID := uuid.Must(uuid.NewV4())
+
+	searches.Register(widgetID, func(ctx context.Context, query string) ([]string, []string, error) {
+		values := make([]string, maxKnownSearchValues)
+		for i := range values {
+			values[i] = fmt.Sprintf("%s-%d", query, i)
+		}
+		return values, nil, nil
+	}, strings.ToUpper)
+
+	for _, query := range []string{"a", "b"} {
+		if _, _, err := searches.Search(context.Background(), widgetID, query); err != nil {
+			t.Fatalf("Search returned error: %v", err)
+		}
+	}
+
+	if _, ok := searches.Label(widgetID, "a-0"); ok {
+		t.Error("Label(a-0) ok = true, want the oldest values to be forgotten")
+	}
+	if _, ok := searches.Label(widgetID, "b-0"); !ok {
+		t.Error("Label(b-0) ok = false, want true")
+	}
+	if got := len(searches.searchers[widgetID].known); got != maxKnownSearchValues {
+		t.Errorf("known values = %d, want %d", got, maxKnownSearchValues)
+	}
+}
+
+func TestSearches_NewSearchCancelsPrevious(t *testing.T) {
+	searches := newSearches()
+	widgetID := uuid.Must(uuid.NewV4())
+
+	started := make(chan struct{})
+	searches.Register(widgetID, func(ctx context.Context, query string) ([]string, []string, error) {
+		if query == "slow" {
+			close(started)
+			<-ctx.Done()
+			return nil, nil, ctx.Err()
+		}
+		return []string{query}, nil, nil
+	}, strings.ToUpper)
+
+	errc := make(chan error, 1)
+	go func() {
+		_, _, err := searches.Search(context.Background(), widgetID, "slow")
+		errc <- err
+	}()
+	<-started
+
+	if _, _, err := searches.Search(context.Background(), widgetID, "fast"); err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+	if err := <-errc; !errors.Is(err, context.Canceled) {
+		t.Errorf("superseded Search error = %v, want %v", err, context.Canceled)
+	}
+}
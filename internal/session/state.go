@@ -311,6 +311,193 @@ func (s *State) GetForm(id uuid.UUID) *state.FormState {
 	return v
 }
 
+func (s *State) GetAlert(id uuid.UUID) *state.AlertState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.AlertState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetMetric(id uuid.UUID) *state.MetricState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.MetricState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetProgress(id uuid.UUID) *state.ProgressState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.ProgressState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetSpinner(id uuid.UUID) *state.SpinnerState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.SpinnerState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetFileUploader(id uuid.UUID) *state.FileUploaderState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.FileUploaderState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetDownloadButton(id uuid.UUID) *state.DownloadButtonState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.DownloadButtonState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetImage(id uuid.UUID) *state.ImageState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.ImageState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetVideo(id uuid.UUID) *state.VideoState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.VideoState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetAudio(id uuid.UUID) *state.AudioState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.AudioState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetCode(id uuid.UUID) *state.CodeState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.CodeState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetJSON(id uuid.UUID) *state.JSONState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.JSONState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
 func (s *State) ResetStates() {
 	s.mu.Lock()
 	defer s.mu.Unlock()
@@ -334,6 +521,12 @@ func (s *State) ResetButtons() {
 				formState.Value = false
 				s.data[id] = formState
 			}
+		case state.WidgetTypeDownloadButton:
+			downloadButtonState, ok := st.(*state.DownloadButtonState)
+			if ok {
+				downloadButtonState.Value = false
+				s.data[id] = downloadButtonState
+			}
 		}
 	}
 }
@@ -351,3 +544,139 @@ func (s *State) SetStates(states map[uuid.UUID]WidgetState) {
 		s.data[id] = state
 	}
 }
+
+func (s *State) GetSlider(id uuid.UUID) *state.SliderState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.SliderState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetRangeSlider(id uuid.UUID) *state.RangeSliderState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.RangeSliderState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetDateRangeSlider(id uuid.UUID) *state.DateRangeSliderState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.DateRangeSliderState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetDateRangeInput(id uuid.UUID) *state.DateRangeInputState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.DateRangeInputState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetToggle(id uuid.UUID) *state.ToggleState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.ToggleState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetColorPicker(id uuid.UUID) *state.ColorPickerState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.ColorPickerState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetRating(id uuid.UUID) *state.RatingState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.RatingState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
+
+func (s *State) GetIntInput(id uuid.UUID) *state.IntInputState {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	st, ok := s.data[id]
+	if !ok {
+		return nil
+	}
+
+	v, ok := st.(*state.IntInputState)
+	if !ok {
+		return nil
+	}
+
+	return v
+}
@@ -0,0 +1,18 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeAlert WidgetType = "alert"
+
+type AlertState struct {
+	ID          uuid.UUID
+	Kind        string
+	Message     string
+	Title       string
+	Icon        string
+	Dismissible bool
+	Dismissed   bool
+}
+
+func (s *AlertState) IsWidgetState()      {}
+func (s *AlertState) GetType() WidgetType { return WidgetTypeAlert }
@@ -0,0 +1,18 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeAudio WidgetType = "audio"
+
+type AudioState struct {
+	ID       uuid.UUID
+	Src      string
+	MediaID  string
+	MimeType string
+	Caption  string
+	Autoplay bool
+	Loop     bool
+}
+
+func (s *AudioState) IsWidgetState()      {}
+func (s *AudioState) GetType() WidgetType { return WidgetTypeAudio }
@@ -0,0 +1,17 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeCode WidgetType = "code"
+
+type CodeState struct {
+	ID             uuid.UUID
+	Code           string
+	Language       string
+	LineNumbers    bool
+	HighlightLines []int32
+	Wrap           bool
+}
+
+func (s *CodeState) IsWidgetState()      {}
+func (s *CodeState) GetType() WidgetType { return WidgetTypeCode }
@@ -0,0 +1,17 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeColorPicker WidgetType = "colorPicker"
+
+type ColorPickerState struct {
+	ID           uuid.UUID
+	Label        string
+	Value        string
+	DefaultValue string
+	Required     bool
+	Disabled     bool
+}
+
+func (s *ColorPickerState) IsWidgetState()      {}
+func (s *ColorPickerState) GetType() WidgetType { return WidgetTypeColorPicker }
@@ -0,0 +1,35 @@
+package state
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+const WidgetTypeDateRangeInput WidgetType = "dateRangeInput"
+
+type DateRangeInputState struct {
+	ID               uuid.UUID
+	FromValue        *time.Time
+	ToValue          *time.Time
+	Label            string
+	Placeholder      string
+	DefaultFromValue *time.Time
+	DefaultToValue   *time.Time
+	Required         bool
+	Disabled         bool
+	Format           string
+	MaxValue         *time.Time
+	MinValue         *time.Time
+	Location         *time.Location
+	Presets          []DateRangeInputStatePreset
+}
+
+type DateRangeInputStatePreset struct {
+	Label string
+	From  time.Time
+	To    time.Time
+}
+
+func (s *DateRangeInputState) IsWidgetState()      {}
+func (s *DateRangeInputState) GetType() WidgetType { return WidgetTypeDateRangeInput }
@@ -0,0 +1,27 @@
+package state
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+const WidgetTypeDateRangeSlider WidgetType = "dateRangeSlider"
+
+type DateRangeSliderState struct {
+	ID               uuid.UUID
+	LowValue         time.Time
+	HighValue        time.Time
+	Label            string
+	DefaultLowValue  time.Time
+	DefaultHighValue time.Time
+	MinValue         time.Time
+	MaxValue         time.Time
+	Step             int
+	Format           string
+	Disabled         bool
+	Location         *time.Location
+}
+
+func (s *DateRangeSliderState) IsWidgetState()      {}
+func (s *DateRangeSliderState) GetType() WidgetType { return WidgetTypeDateRangeSlider }
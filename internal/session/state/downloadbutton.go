@@ -0,0 +1,17 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeDownloadButton WidgetType = "downloadButton"
+
+type DownloadButtonState struct {
+	ID       uuid.UUID
+	Value    bool
+	Label    string
+	Filename string
+	MimeType string
+	Disabled bool
+}
+
+func (s *DownloadButtonState) IsWidgetState()      {}
+func (s *DownloadButtonState) GetType() WidgetType { return WidgetTypeDownloadButton }
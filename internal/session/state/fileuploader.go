@@ -0,0 +1,26 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeFileUploader WidgetType = "fileUploader"
+
+type FileUploaderState struct {
+	ID       uuid.UUID
+	Value    []FileUploaderStateFile
+	Label    string
+	Accept   []string
+	MaxSize  *int64
+	Multiple bool
+	Required bool
+	Disabled bool
+}
+
+type FileUploaderStateFile struct {
+	ID       uuid.UUID
+	Name     string
+	MimeType string
+	Size     int64
+}
+
+func (s *FileUploaderState) IsWidgetState()      {}
+func (s *FileUploaderState) GetType() WidgetType { return WidgetTypeFileUploader }
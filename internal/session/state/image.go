@@ -0,0 +1,17 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeImage WidgetType = "image"
+
+type ImageState struct {
+	ID       uuid.UUID
+	Src      string
+	MediaID  string
+	MimeType string
+	Caption  string
+	Width    *int32
+}
+
+func (s *ImageState) IsWidgetState()      {}
+func (s *ImageState) GetType() WidgetType { return WidgetTypeImage }
@@ -0,0 +1,21 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeIntInput WidgetType = "intInput"
+
+type IntInputState struct {
+	ID           uuid.UUID
+	Value        *int64
+	Label        string
+	Placeholder  string
+	DefaultValue *int64
+	Required     bool
+	Disabled     bool
+	MaxValue     *int64
+	MinValue     *int64
+	Step         *int64
+}
+
+func (s *IntInputState) IsWidgetState()      {}
+func (s *IntInputState) GetType() WidgetType { return WidgetTypeIntInput }
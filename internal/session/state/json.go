@@ -0,0 +1,14 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeJSON WidgetType = "json"
+
+type JSONState struct {
+	ID          uuid.UUID
+	Data        any
+	ExpandDepth *int32
+}
+
+func (s *JSONState) IsWidgetState()      {}
+func (s *JSONState) GetType() WidgetType { return WidgetTypeJSON }
@@ -0,0 +1,19 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeMetric WidgetType = "metric"
+
+type MetricState struct {
+	ID             uuid.UUID
+	Label          string
+	Value          float64
+	FormattedValue string
+	Delta          *float64
+	FormattedDelta string
+	DeltaColor     string
+	Help           string
+}
+
+func (s *MetricState) IsWidgetState()      {}
+func (s *MetricState) GetType() WidgetType { return WidgetTypeMetric }
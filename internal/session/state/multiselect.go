@@ -5,14 +5,19 @@ import "github.com/gofrs/uuid/v5"
 const WidgetTypeMultiSelect WidgetType = "multiSelect"
 
 type MultiSelectState struct {
-	ID           uuid.UUID
-	Label        string
-	Value        []int32
-	Options      []string
-	Placeholder  string
-	DefaultValue []int32
-	Required     bool
-	Disabled     bool
+	ID            uuid.UUID
+	Label         string
+	Value         []int32
+	Options       []string
+	Placeholder   string
+	DefaultValue  []int32
+	Required      bool
+	Disabled      bool
+	Searchable    bool
+	SearchValues  []string
+	SearchLabels  []string
+	Creatable     bool
+	CreatedValues []string
 }
 
 func (s *MultiSelectState) IsWidgetState()      {}
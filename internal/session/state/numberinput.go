@@ -14,6 +14,8 @@ type NumberInputState struct {
 	Disabled     bool
 	MaxValue     *float64
 	MinValue     *float64
+	Step         *float64
+	Precision    *int
 }
 
 func (s *NumberInputState) IsWidgetState()      {}
@@ -0,0 +1,15 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeProgress WidgetType = "progress"
+
+type ProgressState struct {
+	ID    uuid.UUID
+	Label string
+	Value float64
+	Text  string
+}
+
+func (s *ProgressState) IsWidgetState()      {}
+func (s *ProgressState) GetType() WidgetType { return WidgetTypeProgress }
@@ -0,0 +1,22 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeRangeSlider WidgetType = "rangeSlider"
+
+type RangeSliderState struct {
+	ID               uuid.UUID
+	LowValue         float64
+	HighValue        float64
+	Label            string
+	DefaultLowValue  float64
+	DefaultHighValue float64
+	MinValue         float64
+	MaxValue         float64
+	Step             float64
+	Format           string
+	Disabled         bool
+}
+
+func (s *RangeSliderState) IsWidgetState()      {}
+func (s *RangeSliderState) GetType() WidgetType { return WidgetTypeRangeSlider }
@@ -0,0 +1,18 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeRating WidgetType = "rating"
+
+type RatingState struct {
+	ID           uuid.UUID
+	Label        string
+	Value        int
+	MaxValue     int
+	DefaultValue int
+	Required     bool
+	Disabled     bool
+}
+
+func (s *RatingState) IsWidgetState()      {}
+func (s *RatingState) GetType() WidgetType { return WidgetTypeRating }
@@ -13,6 +13,9 @@ type SelectboxState struct {
 	DefaultValue *int32
 	Required     bool
 	Disabled     bool
+	Searchable   bool
+	SearchValue  *string
+	SearchLabel  *string
 }
 
 func (s *SelectboxState) IsWidgetState()      {}
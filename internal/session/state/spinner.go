@@ -0,0 +1,14 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeSpinner WidgetType = "spinner"
+
+type SpinnerState struct {
+	ID    uuid.UUID
+	Label string
+	Done  bool
+}
+
+func (s *SpinnerState) IsWidgetState()      {}
+func (s *SpinnerState) GetType() WidgetType { return WidgetTypeSpinner }
@@ -0,0 +1,20 @@
+package state
+
+import "github.com/gofrs/uuid/v5"
+
+const WidgetTypeVideo WidgetType = "video"
+
+type VideoState struct {
+	ID       uuid.UUID
+	Src      string
+	MediaID  string
+	MimeType string
+	Caption  string
+	Width    *int32
+	Autoplay bool
+	Loop     bool
+	Muted    bool
+}
+
+func (s *VideoState) IsWidgetState()      {}
+func (s *VideoState) GetType() WidgetType { return WidgetTypeVideo }
@@ -0,0 +1,120 @@
+package session
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"github.com/gofrs/uuid/v5"
+)
+
+type Upload struct {
+	ID       uuid.UUID
+	WidgetID uuid.UUID
+	Name     string
+	MimeType string
+	Size     int64
+	data     bytes.Buffer
+	complete bool
+}
+
+func (u *Upload) Complete() bool {
+	return u.complete
+}
+
+func (u *Upload) Bytes() []byte {
+	return u.data.Bytes()
+}
+
+type Uploads struct {
+	files map[uuid.UUID]*Upload
+	mu    sync.RWMutex
+}
+
+func newUploads() *Uploads {
+	return &Uploads{
+		files: make(map[uuid.UUID]*Upload),
+	}
+}
+
+func (u *Uploads) Start(upload *Upload) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.files[upload.ID] = upload
+}
+
+func (u *Uploads) Append(id uuid.UUID, offset int64, data []byte, last bool) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	upload, ok := u.files[id]
+	if !ok {
+		return fmt.Errorf("upload not found: %s", id)
+	}
+	if upload.complete {
+		return fmt.Errorf("upload already completed: %s", id)
+	}
+	if offset != int64(upload.data.Len()) {
+		return fmt.Errorf("unexpected chunk offset %d for upload %s, want %d", offset, id, upload.data.Len())
+	}
+	if offset+int64(len(data)) > upload.Size {
+		return fmt.Errorf("upload %s exceeds declared size of %d bytes", id, upload.Size)
+	}
+
+	upload.data.Write(data)
+
+	if last {
+		if int64(upload.data.Len()) != upload.Size {
+			return fmt.Errorf("upload %s ended at %d bytes, want %d", id, upload.data.Len(), upload.Size)
+		}
+		upload.complete = true
+	}
+
+	return nil
+}
+
+func (u *Uploads) Get(id uuid.UUID) *Upload {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	return u.files[id]
+}
+
+// Replace starts upload and drops every other upload of the same widget,
+// complete or still in progress, for widgets that hold a single file.
+func (u *Uploads) Replace(upload *Upload) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for id, existing := range u.files {
+		if existing.WidgetID == upload.WidgetID {
+			delete(u.files, id)
+		}
+	}
+	u.files[upload.ID] = upload
+}
+
+// Retain drops every completed upload of widgetID that is not listed in ids,
+// so files removed in the browser are not kept in memory. Uploads still in
+// progress are kept, since they only appear in the value once complete.
+func (u *Uploads) Retain(widgetID uuid.UUID, ids []uuid.UUID) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	keep := make(map[uuid.UUID]struct{}, len(ids))
+	for _, id := range ids {
+		keep[id] = struct{}{}
+	}
+	for id, upload := range u.files {
+		if upload.WidgetID != widgetID || !upload.complete {
+			continue
+		}
+		if _, ok := keep[id]; !ok {
+			delete(u.files, id)
+		}
+	}
+}
+
+func (u *Uploads) Reset() {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.files = make(map[uuid.UUID]*Upload)
+}
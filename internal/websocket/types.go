@@ -41,6 +41,26 @@ func NewMessage(id string, payload proto.Message) (*websocketv1.Message, error)
 		msg.Type = &websocketv1.Message_CloseSession{CloseSession: p}
 	case *websocketv1.ScriptFinished:
 		msg.Type = &websocketv1.Message_ScriptFinished{ScriptFinished: p}
+	case *websocketv1.Toast:
+		msg.Type = &websocketv1.Message_Toast{Toast: p}
+	case *websocketv1.FileUploadStart:
+		msg.Type = &websocketv1.Message_FileUploadStart{FileUploadStart: p}
+	case *websocketv1.FileUploadChunk:
+		msg.Type = &websocketv1.Message_FileUploadChunk{FileUploadChunk: p}
+	case *websocketv1.DownloadStart:
+		msg.Type = &websocketv1.Message_DownloadStart{DownloadStart: p}
+	case *websocketv1.DownloadChunk:
+		msg.Type = &websocketv1.Message_DownloadChunk{DownloadChunk: p}
+	case *websocketv1.MediaChunk:
+		msg.Type = &websocketv1.Message_MediaChunk{MediaChunk: p}
+	case *websocketv1.SearchOptions:
+		msg.Type = &websocketv1.Message_SearchOptions{SearchOptions: p}
+	case *websocketv1.SearchOptionsResult:
+		msg.Type = &websocketv1.Message_SearchOptionsResult{SearchOptionsResult: p}
+	case *websocketv1.SetQueryParams:
+		msg.Type = &websocketv1.Message_SetQueryParams{SetQueryParams: p}
+	case *websocketv1.NavigatePage:
+		msg.Type = &websocketv1.Message_NavigatePage{NavigatePage: p}
 	case *exceptionv1.Exception:
 		msg.Type = &websocketv1.Message_Exception{Exception: p}
 	default:
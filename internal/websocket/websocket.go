@@ -123,6 +123,16 @@ type client struct {
 	shutdownOnce sync.Once
 }
 
+// ValidateConfig reports whether NewClient would accept config, after zero
+// values are replaced with their defaults.
+func ValidateConfig(config Config) error {
+	setConfigDefaults(&config)
+	if err := validateConfig(config); err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+	return nil
+}
+
 func NewClient(config Config) (Client, error) {
 	// Set defaults for zero values
 	setConfigDefaults(&config)
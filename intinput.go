@@ -0,0 +1,134 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/intinput"
+)
+
+// IntInput renders a number input that only accepts integers.
+// Values and options travel as int64 end to end, so large IDs are not
+// rounded through float64.
+func IntInput[T ~int | ~int64](ui UIBuilder, label string, opts ...intinput.Option) *T {
+	b, ok := ui.(*uiBuilder)
+	if !ok {
+		return nil
+	}
+	v := b.intInput(label, opts...)
+	if v == nil {
+		return nil
+	}
+	t := T(*v)
+	return &t
+}
+
+func (b *uiBuilder) intInput(label string, opts ...intinput.Option) *int64 {
+	intInputOpts := &options.IntInputOptions{
+		Label:        label,
+		Placeholder:  "",
+		DefaultValue: nil,
+		Required:     false,
+		Disabled:     false,
+		MaxValue:     nil,
+		MinValue:     nil,
+		Step:         nil,
+	}
+
+	for _, o := range opts {
+		o.Apply(intInputOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return nil
+	}
+	page := b.page
+	if page == nil {
+		return nil
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return nil
+	}
+	path := cursor.getPath()
+
+	defaultValue := intInputOpts.DefaultValue
+	step := intInputOpts.Step
+	if step != nil && *step < 1 {
+		step = nil
+	}
+
+	widgetID := b.generatePageID(state.WidgetTypeIntInput, path)
+	intInputState := sess.State.GetIntInput(widgetID)
+	if intInputState == nil {
+		intInputState = &state.IntInputState{
+			ID:    widgetID,
+			Value: defaultValue,
+		}
+	}
+	intInputState.Label = intInputOpts.Label
+	intInputState.Placeholder = intInputOpts.Placeholder
+	intInputState.DefaultValue = defaultValue
+	intInputState.Required = intInputOpts.Required
+	intInputState.Disabled = intInputOpts.Disabled
+	intInputState.MaxValue = intInputOpts.MaxValue
+	intInputState.MinValue = intInputOpts.MinValue
+	intInputState.Step = step
+	sess.State.Set(widgetID, intInputState)
+
+	intInput := convertStateToIntInputProto(intInputState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_IntInput{
+				IntInput: intInput,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return intInputState.Value
+}
+
+func convertStateToIntInputProto(state *state.IntInputState) *widgetv1.IntInput {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.IntInput{
+		Value:        state.Value,
+		Label:        state.Label,
+		Placeholder:  state.Placeholder,
+		DefaultValue: state.DefaultValue,
+		Required:     state.Required,
+		Disabled:     state.Disabled,
+		MaxValue:     state.MaxValue,
+		MinValue:     state.MinValue,
+		Step:         state.Step,
+	}
+}
+
+func convertIntInputProtoToState(id uuid.UUID, data *widgetv1.IntInput) *state.IntInputState {
+	if data == nil {
+		return nil
+	}
+	return &state.IntInputState{
+		ID:           id,
+		Value:        data.Value,
+		Label:        data.Label,
+		Placeholder:  data.Placeholder,
+		DefaultValue: data.DefaultValue,
+		Required:     data.Required,
+		Disabled:     data.Disabled,
+		MaxValue:     data.MaxValue,
+		MinValue:     data.MinValue,
+		Step:         data.Step,
+	}
+}
@@ -0,0 +1,78 @@
+package intinput
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.IntInputOptions)
+}
+
+type placeholderOption string
+
+func (p placeholderOption) Apply(opts *options.IntInputOptions) {
+	opts.Placeholder = string(p)
+}
+
+func WithPlaceholder(placeholder string) Option {
+	return placeholderOption(placeholder)
+}
+
+type defaultValueOption int64
+
+func (d defaultValueOption) Apply(opts *options.IntInputOptions) {
+	opts.DefaultValue = (*int64)(&d)
+}
+
+func WithDefaultValue(value int64) Option {
+	return defaultValueOption(value)
+}
+
+type requiredOption bool
+
+func (r requiredOption) Apply(opts *options.IntInputOptions) {
+	opts.Required = bool(r)
+}
+
+func WithRequired(required bool) Option {
+	return requiredOption(required)
+}
+
+type disabledOption bool
+
+func (d disabledOption) Apply(opts *options.IntInputOptions) {
+	opts.Disabled = bool(d)
+}
+
+func WithDisabled(disabled bool) Option {
+	return disabledOption(disabled)
+}
+
+type maxValueOption int64
+
+func (m maxValueOption) Apply(opts *options.IntInputOptions) {
+	opts.MaxValue = (*int64)(&m)
+}
+
+func WithMaxValue(value int64) Option {
+	return maxValueOption(value)
+}
+
+type minValueOption int64
+
+func (m minValueOption) Apply(opts *options.IntInputOptions) {
+	opts.MinValue = (*int64)(&m)
+}
+
+func WithMinValue(value int64) Option {
+	return minValueOption(value)
+}
+
+type stepOption int64
+
+func (s stepOption) Apply(opts *options.IntInputOptions) {
+	opts.Step = (*int64)(&s)
+}
+
+// WithStep sets the increment of the input. A step below 1 is ignored.
+func WithStep(step int64) Option {
+	return stepOption(step)
+}
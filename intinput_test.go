@@ -0,0 +1,207 @@
+package sourcetool
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/intinput"
+)
+
+func TestConvertStateToIntInputProto(t *testing.T) {
+	value := int64(math.MaxInt64)
+	minValue := int64(0)
+	step := int64(5)
+
+	intInputState := &state.IntInputState{
+		ID:          uuid.Must(uuid.NewV4()),
+		Value:       &value,
+		Label:       "Test IntInput",
+		Placeholder: "Enter ID",
+		Required:    true,
+		MinValue:    &minValue,
+		Step:        &step,
+	}
+
+	data := convertStateToIntInputProto(intInputState)
+
+	if data == nil {
+		t.Fatal("convertStateToIntInputProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Value", *data.Value, value},
+		{"Label", data.Label, intInputState.Label},
+		{"Placeholder", data.Placeholder, intInputState.Placeholder},
+		{"DefaultValue", data.DefaultValue == nil, true},
+		{"Required", data.Required, intInputState.Required},
+		{"MaxValue", data.MaxValue == nil, true},
+		{"MinValue", *data.MinValue, minValue},
+		{"Step", *data.Step, step},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertIntInputProtoToState(t *testing.T) {
+	value := int64(9007199254740993) // not representable as float64
+	data := &widgetv1.IntInput{
+		Value:    &value,
+		Label:    "Test IntInput",
+		Disabled: true,
+	}
+
+	state := convertIntInputProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertIntInputProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Value", *state.Value, value},
+		{"Label", state.Label, data.Label},
+		{"Disabled", state.Disabled, data.Disabled},
+		{"Step", state.Step == nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntInput(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	type quantity int
+	value := IntInput[quantity](builder, "Quantity",
+		intinput.WithDefaultValue(3),
+		intinput.WithMinValue(1),
+		intinput.WithStep(0),
+	)
+
+	if value == nil {
+		t.Fatal("IntInput returned nil")
+	}
+	if *value != 3 {
+		t.Errorf("IntInput value = %d, want 3", *value)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeIntInput, []int{0})
+	state := sess.State.GetIntInput(widgetID)
+	if state == nil {
+		t.Fatal("IntInput state not found")
+	}
+	if *state.MinValue != 1 {
+		t.Errorf("MinValue = %d, want 1", *state.MinValue)
+	}
+	if state.Step != nil {
+		t.Errorf("Step = %d, want nil for a step below 1", *state.Step)
+	}
+
+	// Large IDs sent by the client come back without float rounding.
+	id := int64(9007199254740993)
+	state.Value = &id
+	builder.cursor = newCursor()
+	got := IntInput[int64](builder, "Quantity")
+	if got == nil || *got != id {
+		t.Errorf("IntInput value = %v, want %d", got, id)
+	}
+}
+
+func TestIntInput_LargeValueRoundTrip(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	// 2^53 + 1 is the first integer float64 cannot represent.
+	const large = int64(1<<53 + 1)
+	IntInput[int64](builder, "ID",
+		intinput.WithDefaultValue(large),
+		intinput.WithMinValue(large-2),
+		intinput.WithMaxValue(large+2),
+		intinput.WithStep(1),
+	)
+
+	sent := mockWS.Messages()[0].GetRenderWidget().Widget.GetIntInput()
+	widgetID := builder.generatePageID(state.WidgetTypeIntInput, []int{0})
+	state := convertIntInputProtoToState(widgetID, sent)
+
+	tests := []struct {
+		name string
+		got  int64
+		want int64
+	}{
+		{"Value", *state.Value, large},
+		{"DefaultValue", *state.DefaultValue, large},
+		{"MinValue", *state.MinValue, large - 2},
+		{"MaxValue", *state.MaxValue, large + 2},
+		{"Step", *state.Step, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %d, want %d", tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package sourcetool
+
+import (
+	stdjson "encoding/json"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/json"
+)
+
+func (b *uiBuilder) JSON(v any, opts ...json.Option) error {
+	jsonOpts := &options.JSONOptions{
+		ExpandDepth: nil,
+	}
+
+	for _, o := range opts {
+		o.Apply(jsonOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return nil
+	}
+	page := b.page
+	if page == nil {
+		return nil
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return nil
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeJSON, path)
+	jsonState := sess.State.GetJSON(widgetID)
+	if jsonState == nil {
+		jsonState = &state.JSONState{
+			ID: widgetID,
+		}
+	}
+	jsonState.Data = v
+	jsonState.ExpandDepth = jsonOpts.ExpandDepth
+
+	jsonProto, err := convertStateToJSONProto(jsonState)
+	if err != nil {
+		return err
+	}
+	sess.State.Set(widgetID, jsonState)
+
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Json{
+				Json: jsonProto,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return nil
+}
+
+func convertStateToJSONProto(state *state.JSONState) (*widgetv1.Json, error) {
+	if state == nil {
+		return nil, nil
+	}
+	data, err := stdjson.Marshal(state.Data)
+	if err != nil {
+		return nil, err
+	}
+	return &widgetv1.Json{
+		Data:        data,
+		ExpandDepth: state.ExpandDepth,
+	}, nil
+}
+
+func convertJSONProtoToState(id uuid.UUID, data *widgetv1.Json) *state.JSONState {
+	if data == nil {
+		return nil
+	}
+	return &state.JSONState{
+		ID:          id,
+		Data:        stdjson.RawMessage(data.Data),
+		ExpandDepth: data.ExpandDepth,
+	}
+}
@@ -0,0 +1,19 @@
+package json
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.JSONOptions)
+}
+
+type expandDepthOption int32
+
+func (e expandDepthOption) Apply(opts *options.JSONOptions) {
+	opts.ExpandDepth = (*int32)(&e)
+}
+
+// WithExpandDepth sets how many levels of the tree are expanded initially.
+// By default the whole tree is expanded.
+func WithExpandDepth(depth int32) Option {
+	return expandDepthOption(depth)
+}
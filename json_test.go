@@ -0,0 +1,149 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/json"
+)
+
+func TestConvertStateToJSONProto(t *testing.T) {
+	depth := int32(2)
+	jsonState := &state.JSONState{
+		ID: uuid.Must(uuid.NewV4()),
+		Data: map[string]any{
+			"id":   1,
+			"tags": []string{"a", "b"},
+		},
+		ExpandDepth: &depth,
+	}
+
+	data, err := convertStateToJSONProto(jsonState)
+	if err != nil {
+		t.Fatalf("convertStateToJSONProto returned error: %v", err)
+	}
+	if data == nil {
+		t.Fatal("convertStateToJSONProto returned nil")
+	}
+
+	want := `{"id":1,"tags":["a","b"]}`
+	if string(data.Data) != want {
+		t.Errorf("Data = %s, want %s", data.Data, want)
+	}
+	if data.GetExpandDepth() != depth {
+		t.Errorf("ExpandDepth = %v, want %v", data.GetExpandDepth(), depth)
+	}
+
+	jsonState.Data = make(chan int)
+	if _, err := convertStateToJSONProto(jsonState); err == nil {
+		t.Error("convertStateToJSONProto with unsupported value returned nil error")
+	}
+}
+
+func TestConvertJSONProtoToState(t *testing.T) {
+	data := &widgetv1.Json{
+		Data: []byte(`{"id":1}`),
+	}
+
+	state := convertJSONProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertJSONProtoToState returned nil")
+	}
+	if state.ExpandDepth != nil {
+		t.Errorf("ExpandDepth = %v, want nil", *state.ExpandDepth)
+	}
+
+	roundTrip, err := convertStateToJSONProto(state)
+	if err != nil {
+		t.Fatalf("convertStateToJSONProto returned error: %v", err)
+	}
+	if string(roundTrip.Data) != string(data.Data) {
+		t.Errorf("round trip Data = %s, want %s", roundTrip.Data, data.Data)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	type response struct {
+		Status string `json:"status"`
+		Body   string `json:"body"`
+	}
+	v := response{Status: "ok", Body: "```"}
+
+	if err := builder.JSON(v, json.WithExpandDepth(1)); err != nil {
+		t.Fatalf("JSON returned error: %v", err)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	render := messages[0].GetRenderWidget()
+	if render == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+	want := `{"status":"ok","body":"` + "```" + `"}`
+	if got := string(render.Widget.GetJson().Data); got != want {
+		t.Errorf("Data = %s, want %s", got, want)
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeJSON, []int{0})
+	state := sess.State.GetJSON(widgetID)
+	if state == nil {
+		t.Fatal("JSON state not found")
+	}
+	if *state.ExpandDepth != 1 {
+		t.Errorf("ExpandDepth = %v, want 1", *state.ExpandDepth)
+	}
+}
+
+func TestJSON_MarshalError(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	if err := builder.JSON(func() {}); err == nil {
+		t.Error("JSON with unsupported value returned nil error")
+	}
+	if got := len(mockWS.Messages()); got != 0 {
+		t.Errorf("WebSocket messages count = %d, want 0", got)
+	}
+}
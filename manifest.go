@@ -0,0 +1,69 @@
+package sourcetool
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// PageManifest describes a registered page as it is sent to the server.
+type PageManifest struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Route       string   `json:"route"`
+	Path        []int    `json:"path"`
+	Groups      []string `json:"groups"`
+	Params      []string `json:"params,omitempty"`
+	Icon        string   `json:"icon,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Order       *int     `json:"order,omitempty"`
+	Hidden      bool     `json:"hidden,omitempty"`
+	GroupLabel  string   `json:"groupLabel,omitempty"`
+}
+
+// Manifest returns the registered pages in navigation path order.
+func (s *Sourcetool) Manifest() []PageManifest {
+	s.mu.RLock()
+	pages := sortPagesByPath(s.pages)
+	s.mu.RUnlock()
+
+	manifest := make([]PageManifest, 0, len(pages))
+	for _, p := range pages {
+		groups := p.accessGroups
+		if groups == nil {
+			groups = []string{}
+		}
+		manifest = append(manifest, PageManifest{
+			ID:          p.id.String(),
+			Name:        p.name,
+			Route:       p.route,
+			Path:        p.path,
+			Groups:      groups,
+			Params:      routeParams(p.route),
+			Icon:        p.icon,
+			Description: p.description,
+			Order:       p.order,
+			Hidden:      p.hidden,
+			GroupLabel:  p.groupLabel,
+		})
+	}
+	return manifest
+}
+
+// WriteManifest validates the registered pages like Listen does and writes
+// the manifest to w as indented JSON. The sourcetool manifest command runs
+// an app with the -manifest flag and expects it to call WriteManifest with
+// os.Stdout instead of Listen.
+func (s *Sourcetool) WriteManifest(w io.Writer) error {
+	if err := s.validatePages(); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(s.Manifest(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %v", err)
+	}
+	if _, err := w.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write manifest: %v", err)
+	}
+	return nil
+}
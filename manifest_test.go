@@ -0,0 +1,94 @@
+package sourcetool
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/trysourcetool/sourcetool-go/pageoption"
+)
+
+func TestSourcetool_Manifest(t *testing.T) {
+	config := &Config{
+		APIKey:   "test_apikey",
+		Endpoint: "ws://test.trysourcetool.com",
+	}
+	st := newTestSourcetool(t, config)
+	pageHandler := func(ui UIBuilder) error { return nil }
+	st.Page("/users", "Users", pageHandler, pageoption.WithOrder(2))
+	st.AccessGroups("admin")
+	st.Page("/users/:id", "User", pageHandler, pageoption.WithHidden(true))
+
+	manifest := st.Manifest()
+	if len(manifest) != 2 {
+		t.Fatalf("Manifest() length = %d, want 2", len(manifest))
+	}
+
+	users := findPageByPath(st.pages, "/users")
+	order := 2
+	want := []PageManifest{
+		{
+			ID:     users.id.String(),
+			Name:   "Users",
+			Route:  "/users",
+			Path:   []int{0},
+			Groups: []string{},
+			Order:  &order,
+		},
+		{
+			ID:     findPageByPath(st.pages, "/users/:id").id.String(),
+			Name:   "User",
+			Route:  "/users/:id",
+			Path:   []int{1},
+			Groups: []string{"admin"},
+			Params: []string{"id"},
+			Hidden: true,
+		},
+	}
+	if !reflect.DeepEqual(manifest, want) {
+		t.Errorf("Manifest() = %+v, want %+v", manifest, want)
+	}
+}
+
+func TestSourcetool_WriteManifest(t *testing.T) {
+	config := &Config{
+		APIKey:   "test_apikey",
+		Endpoint: "ws://test.trysourcetool.com",
+	}
+	st := newTestSourcetool(t, config)
+	st.Page("/users", "Users", func(ui UIBuilder) error { return nil })
+
+	var buf bytes.Buffer
+	if err := st.WriteManifest(&buf); err != nil {
+		t.Fatalf("WriteManifest returned error: %v", err)
+	}
+
+	var got []PageManifest
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("failed to decode manifest: %v", err)
+	}
+	if len(got) != 1 || got[0].Route != "/users" {
+		t.Errorf("manifest = %+v, want the /users page", got)
+	}
+	if st.runtime != nil {
+		t.Error("WriteManifest started the runtime")
+	}
+}
+
+func TestSourcetool_WriteManifestInvalidPages(t *testing.T) {
+	config := &Config{
+		APIKey:   "test_apikey",
+		Endpoint: "ws://test.trysourcetool.com",
+	}
+	st := newTestSourcetool(t, config)
+	st.Page("/users/:id/:id", "User", func(ui UIBuilder) error { return nil })
+
+	var buf bytes.Buffer
+	if err := st.WriteManifest(&buf); err == nil {
+		t.Fatal("WriteManifest returned nil error for an invalid route")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("WriteManifest wrote %q for invalid pages", buf.String())
+	}
+}
@@ -0,0 +1,58 @@
+package sourcetool
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+
+	"github.com/gofrs/uuid/v5"
+
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+)
+
+const mediaChunkSize = 64 << 10
+
+// resolveMediaSource turns a media widget source into either a URL or the ID
+// of inline content. Inline bytes are streamed to the browser as MediaChunk
+// messages the first time they are used in a session and referenced by their
+// content hash afterwards.
+func (b *uiBuilder) resolveMediaSource(src any, mimeType string) (url, mediaID, resolvedMimeType string, err error) {
+	switch v := src.(type) {
+	case string:
+		return v, "", mimeType, nil
+	case []byte:
+		if mimeType == "" {
+			mimeType = http.DetectContentType(v)
+		}
+		sum := sha256.Sum256(v)
+		mediaID = hex.EncodeToString(sum[:])
+		if b.session.Media.MarkSent(mediaID) {
+			b.sendMedia(mediaID, mimeType, v)
+		}
+		return "", mediaID, mimeType, nil
+	default:
+		return "", "", "", fmt.Errorf("unsupported media source type: %T", src)
+	}
+}
+
+func (b *uiBuilder) sendMedia(mediaID, mimeType string, data []byte) {
+	size := int64(len(data))
+	offset := 0
+	for {
+		end := min(offset+mediaChunkSize, len(data))
+		b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.MediaChunk{
+			SessionId: b.session.ID.String(),
+			MediaId:   mediaID,
+			MimeType:  mimeType,
+			Size:      size,
+			Offset:    int64(offset),
+			Data:      data[offset:end],
+			Last:      end == len(data),
+		})
+		if end == len(data) {
+			return
+		}
+		offset = end
+	}
+}
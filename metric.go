@@ -0,0 +1,229 @@
+package sourcetool
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/metric"
+)
+
+func (b *uiBuilder) Metric(label string, value float64, opts ...metric.Option) {
+	metricOpts := &options.MetricOptions{
+		Label:       label,
+		Value:       value,
+		Delta:       nil,
+		DeltaColor:  metric.DeltaColorNormal.String(),
+		DeltaFormat: "",
+		Help:        "",
+		Format:      metric.FormatNumber.String(),
+		Currency:    "",
+		Precision:   nil,
+	}
+
+	for _, o := range opts {
+		o.Apply(metricOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeMetric, path)
+	metricState := sess.State.GetMetric(widgetID)
+	if metricState == nil {
+		metricState = &state.MetricState{
+			ID: widgetID,
+		}
+	}
+	metricState.Label = metricOpts.Label
+	metricState.Value = metricOpts.Value
+	metricState.FormattedValue = formatMetricValue(metricOpts.Value, metricOpts.Format, metricOpts.Currency, metricOpts.Precision)
+	metricState.Delta = metricOpts.Delta
+	metricState.FormattedDelta = ""
+	if metricOpts.Delta != nil {
+		deltaFormat := metricOpts.DeltaFormat
+		if deltaFormat == "" {
+			deltaFormat = metricOpts.Format
+		}
+		metricState.FormattedDelta = formatMetricValue(*metricOpts.Delta, deltaFormat, metricOpts.Currency, metricOpts.Precision)
+		if *metricOpts.Delta > 0 {
+			metricState.FormattedDelta = "+" + metricState.FormattedDelta
+		}
+	}
+	metricState.DeltaColor = metricOpts.DeltaColor
+	metricState.Help = metricOpts.Help
+	sess.State.Set(widgetID, metricState)
+
+	metricProto := convertStateToMetricProto(metricState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Metric{
+				Metric: metricProto,
+			},
+		},
+	})
+
+	cursor.next()
+}
+
+var currencySymbols = map[string]string{
+	"USD": "$",
+	"EUR": "€",
+	"GBP": "£",
+	"JPY": "¥",
+	"CNY": "¥",
+	"INR": "₹",
+	"KRW": "₩",
+}
+
+func formatMetricValue(v float64, format, currency string, precision *int) string {
+	switch {
+	case math.IsNaN(v):
+		return "NaN"
+	case math.IsInf(v, 1):
+		return "∞"
+	case math.IsInf(v, -1):
+		return "-∞"
+	}
+
+	switch metric.Format(format) {
+	case metric.FormatCurrency:
+		p := 2
+		if precision != nil {
+			p = *precision
+		}
+		code := strings.ToUpper(currency)
+		if code == "" {
+			code = "USD"
+		}
+		number := groupThousands(strconv.FormatFloat(math.Abs(v), 'f', p, 64))
+		sign := ""
+		if v < 0 {
+			sign = "-"
+		}
+		if symbol, ok := currencySymbols[code]; ok {
+			return sign + symbol + number
+		}
+		return sign + number + " " + code
+	case metric.FormatPercent:
+		p := 0
+		if precision != nil {
+			p = *precision
+		}
+		return strconv.FormatFloat(v*100, 'f', p, 64) + "%"
+	case metric.FormatCompact:
+		p := 1
+		if precision != nil {
+			p = *precision
+		}
+		units := []struct {
+			threshold float64
+			suffix    string
+		}{
+			{1, ""},
+			{1e3, "k"},
+			{1e6, "M"},
+			{1e9, "B"},
+			{1e12, "T"},
+		}
+		i := 0
+		for i < len(units)-1 && math.Abs(v) >= units[i+1].threshold {
+			i++
+		}
+		for {
+			s := strconv.FormatFloat(v/units[i].threshold, 'f', p, 64)
+			// Carry over to the next unit when rounding reaches 1000,
+			// so 999,950 becomes "1M" rather than "1000k".
+			if rounded, _ := strconv.ParseFloat(s, 64); math.Abs(rounded) >= 1000 && i < len(units)-1 {
+				i++
+				continue
+			}
+			return trimZeroFraction(s) + units[i].suffix
+		}
+	default:
+		p := -1
+		if precision != nil {
+			p = *precision
+		}
+		return groupThousands(strconv.FormatFloat(v, 'f', p, 64))
+	}
+}
+
+func groupThousands(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	intPart, fracPart, hasFrac := strings.Cut(s, ".")
+	var sb strings.Builder
+	for i, c := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteRune(c)
+	}
+	if hasFrac {
+		return sign + sb.String() + "." + fracPart
+	}
+	return sign + sb.String()
+}
+
+func trimZeroFraction(s string) string {
+	if !strings.Contains(s, ".") {
+		return s
+	}
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+func convertStateToMetricProto(state *state.MetricState) *widgetv1.Metric {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Metric{
+		Label:          state.Label,
+		Value:          state.Value,
+		FormattedValue: state.FormattedValue,
+		Delta:          state.Delta,
+		FormattedDelta: state.FormattedDelta,
+		DeltaColor:     state.DeltaColor,
+		Help:           state.Help,
+	}
+}
+
+func convertMetricProtoToState(id uuid.UUID, data *widgetv1.Metric) *state.MetricState {
+	if data == nil {
+		return nil
+	}
+	return &state.MetricState{
+		ID:             id,
+		Label:          data.Label,
+		Value:          data.Value,
+		FormattedValue: data.FormattedValue,
+		Delta:          data.Delta,
+		FormattedDelta: data.FormattedDelta,
+		DeltaColor:     data.DeltaColor,
+		Help:           data.Help,
+	}
+}
@@ -0,0 +1,78 @@
+package metric
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.MetricOptions)
+}
+
+type deltaOption float64
+
+func (d deltaOption) Apply(opts *options.MetricOptions) {
+	opts.Delta = (*float64)(&d)
+}
+
+func WithDelta(delta float64) Option {
+	return deltaOption(delta)
+}
+
+type deltaColorOption DeltaColor
+
+func (d deltaColorOption) Apply(opts *options.MetricOptions) {
+	opts.DeltaColor = DeltaColor(d).String()
+}
+
+func WithDeltaColor(color DeltaColor) Option {
+	return deltaColorOption(color)
+}
+
+type deltaFormatOption Format
+
+func (d deltaFormatOption) Apply(opts *options.MetricOptions) {
+	opts.DeltaFormat = Format(d).String()
+}
+
+func WithDeltaFormat(format Format) Option {
+	return deltaFormatOption(format)
+}
+
+type helpOption string
+
+func (h helpOption) Apply(opts *options.MetricOptions) {
+	opts.Help = string(h)
+}
+
+func WithHelp(help string) Option {
+	return helpOption(help)
+}
+
+type formatOption Format
+
+func (f formatOption) Apply(opts *options.MetricOptions) {
+	opts.Format = Format(f).String()
+}
+
+func WithFormat(format Format) Option {
+	return formatOption(format)
+}
+
+type currencyOption string
+
+func (c currencyOption) Apply(opts *options.MetricOptions) {
+	opts.Format = FormatCurrency.String()
+	opts.Currency = string(c)
+}
+
+func WithCurrency(currency string) Option {
+	return currencyOption(currency)
+}
+
+type precisionOption int
+
+func (p precisionOption) Apply(opts *options.MetricOptions) {
+	opts.Precision = (*int)(&p)
+}
+
+func WithPrecision(precision int) Option {
+	return precisionOption(precision)
+}
@@ -0,0 +1,27 @@
+package metric
+
+type DeltaColor string
+
+const (
+	DeltaColorNormal  DeltaColor = "normal"
+	DeltaColorInverse DeltaColor = "inverse"
+	DeltaColorOff     DeltaColor = "off"
+)
+
+func (d DeltaColor) String() string {
+	return string(d)
+}
+
+type Format string
+
+const (
+	FormatNumber   Format = "number"
+	FormatCurrency Format = "currency"
+	// FormatPercent treats the value as a ratio, so 0.04 is rendered as 4%.
+	FormatPercent Format = "percent"
+	FormatCompact Format = "compact"
+)
+
+func (f Format) String() string {
+	return string(f)
+}
@@ -0,0 +1,246 @@
+package sourcetool
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/metric"
+)
+
+func TestConvertStateToMetricProto(t *testing.T) {
+	id := uuid.Must(uuid.NewV4())
+	delta := 0.04
+
+	metricState := &state.MetricState{
+		ID:             id,
+		Label:          "Revenue",
+		Value:          12300,
+		FormattedValue: "$12.3k",
+		Delta:          &delta,
+		FormattedDelta: "+4%",
+		DeltaColor:     metric.DeltaColorNormal.String(),
+		Help:           "Total revenue this month",
+	}
+
+	data := convertStateToMetricProto(metricState)
+
+	if data == nil {
+		t.Fatal("convertStateToMetricProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", data.Label, metricState.Label},
+		{"Value", data.Value, metricState.Value},
+		{"FormattedValue", data.FormattedValue, metricState.FormattedValue},
+		{"Delta", data.GetDelta(), *metricState.Delta},
+		{"FormattedDelta", data.FormattedDelta, metricState.FormattedDelta},
+		{"DeltaColor", data.DeltaColor, metricState.DeltaColor},
+		{"Help", data.Help, metricState.Help},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertMetricProtoToState(t *testing.T) {
+	delta := -3.0
+	data := &widgetv1.Metric{
+		Label:          "Errors",
+		Value:          42,
+		FormattedValue: "42",
+		Delta:          &delta,
+		FormattedDelta: "-3",
+		DeltaColor:     metric.DeltaColorInverse.String(),
+		Help:           "Errors in the last hour",
+	}
+
+	state := convertMetricProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertMetricProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, data.Label},
+		{"Value", state.Value, data.Value},
+		{"FormattedValue", state.FormattedValue, data.FormattedValue},
+		{"Delta", *state.Delta, data.GetDelta()},
+		{"FormattedDelta", state.FormattedDelta, data.FormattedDelta},
+		{"DeltaColor", state.DeltaColor, data.DeltaColor},
+		{"Help", state.Help, data.Help},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatMetricValue(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name      string
+		value     float64
+		format    metric.Format
+		currency  string
+		precision *int
+		want      string
+	}{
+		{"Number", 1234567.5, metric.FormatNumber, "", nil, "1,234,567.5"},
+		{"Number with precision", 1234.5678, metric.FormatNumber, "", intPtr(2), "1,234.57"},
+		{"Negative number", -1234, metric.FormatNumber, "", nil, "-1,234"},
+		{"Currency", 12345.6, metric.FormatCurrency, "USD", nil, "$12,345.60"},
+		{"Negative currency", -5, metric.FormatCurrency, "EUR", nil, "-€5.00"},
+		{"Unknown currency", 10, metric.FormatCurrency, "chf", nil, "10.00 CHF"},
+		{"Percent", 0.04, metric.FormatPercent, "", nil, "4%"},
+		{"Percent with precision", 0.1234, metric.FormatPercent, "", intPtr(1), "12.3%"},
+		{"Compact thousands", 12300, metric.FormatCompact, "", nil, "12.3k"},
+		{"Compact millions", 2000000, metric.FormatCompact, "", nil, "2M"},
+		{"Compact small", 999, metric.FormatCompact, "", nil, "999"},
+		{"Compact rounds up to next unit", 999950, metric.FormatCompact, "", nil, "1M"},
+		{"Compact rounds small up to thousands", 999.96, metric.FormatCompact, "", nil, "1k"},
+		{"Compact negative rollover", -999999, metric.FormatCompact, "", nil, "-1M"},
+		{"Compact beyond trillions", 1.5e15, metric.FormatCompact, "", nil, "1500T"},
+		{"Positive infinity", math.Inf(1), metric.FormatNumber, "", nil, "∞"},
+		{"Negative infinity currency", math.Inf(-1), metric.FormatCurrency, "USD", nil, "-∞"},
+		{"NaN compact", math.NaN(), metric.FormatCompact, "", nil, "NaN"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := formatMetricValue(tt.value, tt.format.String(), tt.currency, tt.precision)
+			if got != tt.want {
+				t.Errorf("formatMetricValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetric(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	label := "Revenue"
+	builder.Metric(label, 12345.6,
+		metric.WithCurrency("USD"),
+		metric.WithDelta(0.04),
+		metric.WithDeltaFormat(metric.FormatPercent),
+		metric.WithDeltaColor(metric.DeltaColorInverse),
+		metric.WithHelp("Month to date"),
+	)
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0]
+	if v := msg.GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeMetric, []int{0})
+	state := sess.State.GetMetric(widgetID)
+	if state == nil {
+		t.Fatal("Metric state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, label},
+		{"Value", state.Value, 12345.6},
+		{"FormattedValue", state.FormattedValue, "$12,345.60"},
+		{"Delta", *state.Delta, 0.04},
+		{"FormattedDelta", state.FormattedDelta, "+4%"},
+		{"DeltaColor", state.DeltaColor, metric.DeltaColorInverse.String()},
+		{"Help", state.Help, "Month to date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMetric_InColumns(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	cols := builder.Columns(3)
+	for i, col := range cols {
+		col.Metric("KPI", float64(i))
+	}
+
+	for i := range cols {
+		widgetID := builder.generatePageID(state.WidgetTypeMetric, []int{0, i, 0})
+		state := sess.State.GetMetric(widgetID)
+		if state == nil {
+			t.Fatalf("Metric state in column %d not found", i)
+		}
+		if state.Value != float64(i) {
+			t.Errorf("Value = %v, want %v", state.Value, i)
+		}
+		if state.Delta != nil {
+			t.Errorf("Default Delta = %v, want nil", *state.Delta)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package sourcetool
 
 import (
+	"slices"
+	"strings"
+
 	"github.com/gofrs/uuid/v5"
 
 	"github.com/trysourcetool/sourcetool-go/internal/options"
@@ -18,6 +21,8 @@ func (b *uiBuilder) MultiSelect(label string, opts ...multiselect.Option) *multi
 		Required:     false,
 		Disabled:     false,
 		FormatFunc:   nil,
+		SearchFunc:   nil,
+		Creatable:    false,
 	}
 
 	for _, o := range opts {
@@ -57,6 +62,26 @@ func (b *uiBuilder) MultiSelect(label string, opts ...multiselect.Option) *multi
 			ID:    widgetID,
 			Value: defaultVal,
 		}
+		if multiSelectOpts.SearchFunc != nil {
+			multiSelectState.SearchValues = slices.Clone(multiSelectOpts.DefaultValue)
+		} else if multiSelectOpts.Creatable {
+			for _, v := range multiSelectOpts.DefaultValue {
+				if !slices.Contains(multiSelectOpts.Options, v) {
+					multiSelectState.CreatedValues = append(multiSelectState.CreatedValues, v)
+				}
+			}
+		}
+		if v, ok := b.lookupQueryParam(multiSelectOpts.QueryParam); ok && multiSelectOpts.SearchFunc == nil {
+			multiSelectState.Value = nil
+			multiSelectState.CreatedValues = nil
+			for _, s := range v {
+				if idx := slices.Index(multiSelectOpts.Options, s); idx >= 0 {
+					multiSelectState.Value = append(multiSelectState.Value, int32(idx))
+				} else if multiSelectOpts.Creatable {
+					multiSelectState.CreatedValues = append(multiSelectState.CreatedValues, s)
+				}
+			}
+		}
 	}
 	if multiSelectOpts.FormatFunc == nil {
 		multiSelectOpts.FormatFunc = func(v string, i int) string {
@@ -69,12 +94,65 @@ func (b *uiBuilder) MultiSelect(label string, opts ...multiselect.Option) *multi
 		displayVals[i] = multiSelectOpts.FormatFunc(v, i)
 	}
 
+	var searchIndexes []int
+	if multiSelectOpts.SearchFunc != nil {
+		formatFunc := multiSelectOpts.FormatFunc
+		sess.Searches.Register(widgetID, multiSelectOpts.SearchFunc, func(v string) string {
+			return formatFunc(v, -1)
+		})
+
+		multiSelectState.Value = nil
+		searchValues := make([]string, 0, len(multiSelectState.SearchValues))
+		searchLabels := make([]string, 0, len(multiSelectState.SearchValues))
+		for _, v := range multiSelectState.SearchValues {
+			idx := slices.Index(multiSelectOpts.Options, v)
+			label, known := sess.Searches.Label(widgetID, v)
+			if idx >= 0 || (!known && slices.Contains(multiSelectOpts.DefaultValue, v)) {
+				label, known = formatFunc(v, idx), true
+			}
+			if !known {
+				continue
+			}
+			searchValues = append(searchValues, v)
+			searchLabels = append(searchLabels, label)
+			searchIndexes = append(searchIndexes, idx)
+		}
+		multiSelectState.SearchValues = searchValues
+		multiSelectState.SearchLabels = searchLabels
+	}
+
+	if multiSelectOpts.Creatable {
+		selected := slices.Clone(multiSelectState.SearchValues)
+		for _, idx := range multiSelectState.Value {
+			selected = append(selected, multiSelectOpts.Options[idx])
+		}
+		created := make([]string, 0, len(multiSelectState.CreatedValues))
+		for _, v := range multiSelectState.CreatedValues {
+			v = strings.TrimSpace(v)
+			if v == "" || slices.Contains(selected, v) || slices.Contains(created, v) {
+				continue
+			}
+			// A typed value that matches an option selects that option instead.
+			if idx := slices.Index(multiSelectOpts.Options, v); idx >= 0 && multiSelectOpts.SearchFunc == nil {
+				multiSelectState.Value = append(multiSelectState.Value, int32(idx))
+				selected = append(selected, v)
+				continue
+			}
+			created = append(created, v)
+		}
+		multiSelectState.CreatedValues = created
+	} else {
+		multiSelectState.CreatedValues = nil
+	}
+
 	multiSelectState.Label = multiSelectOpts.Label
 	multiSelectState.Options = displayVals
 	multiSelectState.Placeholder = multiSelectOpts.Placeholder
 	multiSelectState.DefaultValue = defaultVal
 	multiSelectState.Required = multiSelectOpts.Required
 	multiSelectState.Disabled = multiSelectOpts.Disabled
+	multiSelectState.Searchable = multiSelectOpts.SearchFunc != nil
+	multiSelectState.Creatable = multiSelectOpts.Creatable
 	sess.State.Set(widgetID, multiSelectState)
 
 	multiSelectProto := convertStateToMultiSelectProto(multiSelectState)
@@ -93,7 +171,14 @@ func (b *uiBuilder) MultiSelect(label string, opts ...multiselect.Option) *multi
 	cursor.next()
 
 	var value *multiselect.Value
-	if multiSelectState.Value != nil {
+	if multiSelectState.Searchable {
+		if len(multiSelectState.SearchValues) > 0 {
+			value = &multiselect.Value{
+				Values:  slices.Clone(multiSelectState.SearchValues),
+				Indexes: searchIndexes,
+			}
+		}
+	} else if multiSelectState.Value != nil {
 		value = &multiselect.Value{
 			Values:  make([]string, len(multiSelectState.Value)),
 			Indexes: make([]int, len(multiSelectState.Value)),
@@ -103,6 +188,23 @@ func (b *uiBuilder) MultiSelect(label string, opts ...multiselect.Option) *multi
 			value.Indexes[i] = int(idx)
 		}
 	}
+	if len(multiSelectState.CreatedValues) > 0 {
+		if value == nil {
+			value = &multiselect.Value{}
+		}
+		for _, v := range multiSelectState.CreatedValues {
+			value.Values = append(value.Values, v)
+			value.Indexes = append(value.Indexes, -1)
+		}
+	}
+
+	if !multiSelectState.Searchable {
+		var values []string
+		if value != nil {
+			values = value.Values
+		}
+		b.bindQueryParam(multiSelectOpts.QueryParam, values, slices.Equal(values, multiSelectOpts.DefaultValue))
+	}
 
 	return value
 }
@@ -112,13 +214,18 @@ func convertStateToMultiSelectProto(state *state.MultiSelectState) *widgetv1.Mul
 		return nil
 	}
 	return &widgetv1.MultiSelect{
-		Label:        state.Label,
-		Value:        state.Value,
-		Options:      state.Options,
-		Placeholder:  state.Placeholder,
-		DefaultValue: state.DefaultValue,
-		Required:     state.Required,
-		Disabled:     state.Disabled,
+		Label:         state.Label,
+		Value:         state.Value,
+		Options:       state.Options,
+		Placeholder:   state.Placeholder,
+		DefaultValue:  state.DefaultValue,
+		Required:      state.Required,
+		Disabled:      state.Disabled,
+		Searchable:    state.Searchable,
+		SearchValues:  state.SearchValues,
+		SearchLabels:  state.SearchLabels,
+		Creatable:     state.Creatable,
+		CreatedValues: state.CreatedValues,
 	}
 }
 
@@ -127,13 +234,18 @@ func convertMultiSelectProtoToState(id uuid.UUID, data *widgetv1.MultiSelect) *s
 		return nil
 	}
 	return &state.MultiSelectState{
-		ID:           id,
-		Label:        data.Label,
-		Value:        data.Value,
-		Options:      data.Options,
-		Placeholder:  data.Placeholder,
-		DefaultValue: data.DefaultValue,
-		Required:     data.Required,
-		Disabled:     data.Disabled,
+		ID:            id,
+		Label:         data.Label,
+		Value:         data.Value,
+		Options:       data.Options,
+		Placeholder:   data.Placeholder,
+		DefaultValue:  data.DefaultValue,
+		Required:      data.Required,
+		Disabled:      data.Disabled,
+		Searchable:    data.Searchable,
+		SearchValues:  data.SearchValues,
+		SearchLabels:  data.SearchLabels,
+		Creatable:     data.Creatable,
+		CreatedValues: data.CreatedValues,
 	}
 }
@@ -1,6 +1,10 @@
 package multiselect
 
-import "github.com/trysourcetool/sourcetool-go/internal/options"
+import (
+	"context"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+)
 
 type Option interface {
 	Apply(*options.MultiSelectOptions)
@@ -65,3 +69,64 @@ func (f formatFuncOption) Apply(opts *options.MultiSelectOptions) {
 func WithFormatFunc(formatFunc func(string, int) string) Option {
 	return formatFuncOption(formatFunc)
 }
+
+// SearchResult is a value returned by a search callback. Label is shown in
+// place of the value; an empty Label falls back to FormatFunc.
+type SearchResult struct {
+	Value string
+	Label string
+}
+
+type searchFuncOption func(context.Context, string) ([]SearchResult, error)
+
+func (s searchFuncOption) Apply(opts *options.MultiSelectOptions) {
+	opts.SearchFunc = func(ctx context.Context, query string) ([]string, []string, error) {
+		results, err := s(ctx, query)
+		if err != nil {
+			return nil, nil, err
+		}
+		values := make([]string, len(results))
+		labels := make([]string, len(results))
+		for i, r := range results {
+			values[i] = r.Value
+			labels[i] = r.Label
+		}
+		return values, labels, nil
+	}
+}
+
+// WithSearch loads options on demand. The browser sends what the user types
+// and searchFunc answers with matching values without rerunning the page.
+// searchFunc runs outside the page handler, and its context is cancelled
+// when a newer query arrives or the search takes too long.
+// Options given with WithOptions are shown before the first search, and
+// FormatFunc receives -1 as the index of searched values without a label.
+func WithSearch(searchFunc func(ctx context.Context, query string) ([]SearchResult, error)) Option {
+	return searchFuncOption(searchFunc)
+}
+
+type creatableOption bool
+
+func (c creatableOption) Apply(opts *options.MultiSelectOptions) {
+	opts.Creatable = bool(c)
+}
+
+// WithCreatable lets users add values that are not in the options list.
+// Created values are returned in Value.Values with an index of -1.
+func WithCreatable(creatable bool) Option {
+	return creatableOption(creatable)
+}
+
+type queryParamOption string
+
+func (q queryParamOption) Apply(opts *options.MultiSelectOptions) {
+	opts.QueryParam = string(q)
+}
+
+// WithQueryParam binds the value to the key query parameter of the page URL.
+// The parameter sets the initial value and is updated when the value changes.
+// It has no effect together with WithSearch, since a value from the URL was
+// not returned by a search.
+func WithQueryParam(key string) Option {
+	return queryParamOption(key)
+}
@@ -226,3 +226,173 @@ func TestMultiSelect_WithFormatFunc(t *testing.T) {
 		t.Errorf("Formatted options = %v, want %v", state.Options, expectedOptions)
 	}
 }
+
+func TestMultiSelect_WithSearch(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	newBuilder := func() *uiBuilder {
+		return &uiBuilder{
+			context: context.Background(),
+			session: sess,
+			cursor:  newCursor(),
+			page: &page{
+				id: pageID,
+			},
+			runtime: &runtime{
+				wsClient: mockWS,
+			},
+		}
+	}
+
+	search := func(ctx context.Context, query string) ([]multiselect.SearchResult, error) {
+		return []multiselect.SearchResult{
+			{Value: query + "-1"},
+			{Value: query + "-2", Label: "Go (2)"},
+		}, nil
+	}
+	opts := []multiselect.Option{
+		multiselect.WithOptions("recent"),
+		multiselect.WithDefaultValue("pinned"),
+		multiselect.WithSearch(search),
+	}
+
+	builder := newBuilder()
+	value := builder.MultiSelect("Tags", opts...)
+	if value == nil || !reflect.DeepEqual(value.Values, []string{"pinned"}) {
+		t.Fatalf("MultiSelect default value = %v, want [pinned]", value)
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeMultiSelect, []int{0})
+	if _, _, err := sess.Searches.Search(context.Background(), widgetID, "go"); err != nil {
+		t.Fatalf("Search returned error: %v", err)
+	}
+
+	sess.State.GetMultiSelect(widgetID).SearchValues = []string{"recent", "go-2", "pinned", "forged"}
+
+	value = newBuilder().MultiSelect("Tags", opts...)
+	if value == nil {
+		t.Fatal("MultiSelect returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Values", value.Values, []string{"recent", "go-2", "pinned"}},
+		{"Indexes", value.Indexes, []int{0, -1, -1}},
+		{"SearchLabels", sess.State.GetMultiSelect(widgetID).SearchLabels, []string{"recent", "Go (2)", "pinned"}},
+		{"Searchable", sess.State.GetMultiSelect(widgetID).Searchable, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiSelect_WithCreatable(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	newBuilder := func() *uiBuilder {
+		return &uiBuilder{
+			context: context.Background(),
+			session: sess,
+			cursor:  newCursor(),
+			page: &page{
+				id: pageID,
+			},
+			runtime: &runtime{
+				wsClient: mockWS,
+			},
+		}
+	}
+
+	opts := []multiselect.Option{
+		multiselect.WithOptions("bug", "feature"),
+		multiselect.WithDefaultValue("bug", "triage"),
+		multiselect.WithCreatable(true),
+	}
+
+	builder := newBuilder()
+	value := builder.MultiSelect("Labels", opts...)
+	if value == nil {
+		t.Fatal("MultiSelect returned nil")
+	}
+	if want := []string{"bug", "triage"}; !reflect.DeepEqual(value.Values, want) {
+		t.Errorf("default Values = %v, want %v", value.Values, want)
+	}
+	if want := []int{0, -1}; !reflect.DeepEqual(value.Indexes, want) {
+		t.Errorf("default Indexes = %v, want %v", value.Indexes, want)
+	}
+
+	// The browser sends back typed values, including blanks, duplicates and
+	// one that matches an existing option.
+	widgetID := builder.generatePageID(state.WidgetTypeMultiSelect, []int{0})
+	multiSelectState := sess.State.GetMultiSelect(widgetID)
+	multiSelectState.CreatedValues = []string{"triage", " urgent ", "", "urgent", "feature"}
+
+	value = newBuilder().MultiSelect("Labels", opts...)
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Values", value.Values, []string{"bug", "feature", "triage", "urgent"}},
+		{"Indexes", value.Indexes, []int{0, 1, -1, -1}},
+		{"CreatedValues", sess.State.GetMultiSelect(widgetID).CreatedValues, []string{"triage", "urgent"}},
+		{"Creatable", sess.State.GetMultiSelect(widgetID).Creatable, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	// Created values persist across reruns.
+	value = newBuilder().MultiSelect("Labels", opts...)
+	if want := []string{"bug", "feature", "triage", "urgent"}; !reflect.DeepEqual(value.Values, want) {
+		t.Errorf("Values after rerun = %v, want %v", value.Values, want)
+	}
+
+	// Without WithCreatable, values typed by the client are ignored.
+	value = newBuilder().MultiSelect("Labels", multiselect.WithOptions("bug", "feature"))
+	if want := []string{"bug", "feature"}; !reflect.DeepEqual(value.Values, want) {
+		t.Errorf("Values without creatable = %v, want %v", value.Values, want)
+	}
+}
+
+func TestConvertMultiSelectProtoToState_Created(t *testing.T) {
+	data := &widgetv1.MultiSelect{
+		Label:         "Labels",
+		Creatable:     true,
+		CreatedValues: []string{"urgent"},
+	}
+
+	state := convertMultiSelectProtoToState(uuid.Must(uuid.NewV4()), data)
+	if !state.Creatable {
+		t.Error("Creatable = false, want true")
+	}
+	if !reflect.DeepEqual(state.CreatedValues, data.CreatedValues) {
+		t.Errorf("CreatedValues = %v, want %v", state.CreatedValues, data.CreatedValues)
+	}
+
+	if got := convertStateToMultiSelectProto(state).CreatedValues; !reflect.DeepEqual(got, data.CreatedValues) {
+		t.Errorf("round trip CreatedValues = %v, want %v", got, data.CreatedValues)
+	}
+}
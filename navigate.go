@@ -0,0 +1,114 @@
+package sourcetool
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/navigate"
+)
+
+// Navigate switches the browser to the page registered at route, filling its
+// route parameters from params. The switch happens once the current handler
+// returns successfully, so callers usually return right after navigating.
+// A later call in the same run replaces an earlier one.
+func (b *uiBuilder) Navigate(route string, params map[string]string, opts ...navigate.Option) error {
+	navigateOpts := &options.NavigateOptions{
+		KeepState: false,
+	}
+
+	for _, o := range opts {
+		o.Apply(navigateOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return nil
+	}
+
+	target := b.runtime.pageManager.getPageByRoute(route)
+	if target == nil {
+		return fmt.Errorf("page not found: %s", route)
+	}
+	if !target.hasAccess(sess.UserGroups) {
+		return fmt.Errorf("access denied to page: %s", route)
+	}
+
+	urlPath, err := buildRoutePath(target.route, params)
+	if err != nil {
+		return err
+	}
+	routeParams, ok := target.matchPath(urlPath)
+	if !ok {
+		return fmt.Errorf("path %q does not match route %q", urlPath, target.route)
+	}
+
+	sess.PendingNavigation = &session.Navigation{
+		PageID:    target.id,
+		Params:    routeParams,
+		URLPath:   urlPath,
+		KeepState: navigateOpts.KeepState,
+	}
+
+	return nil
+}
+
+// applyNavigation switches sess to the page recorded by Navigate during the
+// run that just finished and tells the browser to follow.
+func (r *runtime) applyNavigation(sess *session.Session) {
+	nav := sess.PendingNavigation
+	if nav == nil {
+		return
+	}
+	sess.PendingNavigation = nil
+
+	if !nav.KeepState {
+		sess.State.ResetStates()
+		sess.Uploads.Reset()
+		sess.Searches.Reset()
+	}
+	sess.PageID = nav.PageID
+	sess.Params = nav.Params
+	sess.QueryParams = url.Values{}
+
+	r.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.NavigatePage{
+		SessionId: sess.ID.String(),
+		PageId:    nav.PageID.String(),
+		UrlPath:   nav.URLPath,
+		KeepState: nav.KeepState,
+	})
+}
+
+// buildRoutePath fills the ":name" and "*name" segments of route with the
+// matching values of params.
+func buildRoutePath(route string, params map[string]string) (string, error) {
+	segs := strings.Split(route, "/")
+	for i, seg := range segs {
+		if !strings.HasPrefix(seg, ":") && !strings.HasPrefix(seg, "*") {
+			continue
+		}
+		name := seg[1:]
+		v, ok := params[name]
+		if !ok {
+			return "", fmt.Errorf("missing route parameter %q for route %q", name, route)
+		}
+		if strings.HasPrefix(seg, "*") {
+			rest := strings.Split(strings.Trim(v, "/"), "/")
+			for j, s := range rest {
+				rest[j] = url.PathEscape(s)
+			}
+			segs[i] = strings.Join(rest, "/")
+			continue
+		}
+		if v == "" {
+			return "", fmt.Errorf("empty route parameter %q for route %q", name, route)
+		}
+		segs[i] = url.PathEscape(v)
+	}
+	return strings.Join(segs, "/"), nil
+}
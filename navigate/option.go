@@ -0,0 +1,19 @@
+package navigate
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.NavigateOptions)
+}
+
+type keepStateOption bool
+
+func (k keepStateOption) Apply(opts *options.NavigateOptions) {
+	opts.KeepState = bool(k)
+}
+
+// WithKeepState keeps the widget state of the session when switching pages.
+// By default the state is reset, as if the user opened the page directly.
+func WithKeepState(keepState bool) Option {
+	return keepStateOption(keepState)
+}
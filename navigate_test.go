@@ -0,0 +1,239 @@
+package sourcetool
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/navigate"
+)
+
+func TestBuildRoutePath(t *testing.T) {
+	tests := []struct {
+		name    string
+		route   string
+		params  map[string]string
+		want    string
+		wantErr bool
+	}{
+		{"Static", "/customers", nil, "/customers", false},
+		{"Param", "/customers/:id", map[string]string{"id": "42"}, "/customers/42", false},
+		{"Escaped", "/customers/:id", map[string]string{"id": "a b"}, "/customers/a%20b", false},
+		{"Wildcard", "/files/*rest", map[string]string{"rest": "a/b c"}, "/files/a/b%20c", false},
+		{"Missing", "/customers/:id", nil, "", true},
+		{"Empty", "/customers/:id", map[string]string{"id": ""}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildRoutePath(tt.route, tt.params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildRoutePath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("buildRoutePath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNavigate(t *testing.T) {
+	fromID := uuid.Must(uuid.NewV4())
+	toID := uuid.Must(uuid.NewV4())
+	adminID := uuid.Must(uuid.NewV4())
+	pages := map[uuid.UUID]*page{
+		fromID:  {id: fromID, name: "Customers", route: "/customers"},
+		toID:    {id: toID, name: "Customer", route: "/customers/:id"},
+		adminID: {id: adminID, name: "Admin", route: "/admin", accessGroups: []string{"admin"}},
+	}
+
+	newBuilder := func(wsClient websocket.Client) (*uiBuilder, *session.Session) {
+		sess := session.New(uuid.Must(uuid.NewV4()), fromID)
+		return &uiBuilder{
+			context: context.Background(),
+			session: sess,
+			cursor:  newCursor(),
+			page:    pages[fromID],
+			runtime: &runtime{
+				wsClient:    wsClient,
+				pageManager: newPageManager(pages),
+			},
+		}, sess
+	}
+
+	t.Run("ResetState", func(t *testing.T) {
+		mockWS := mock.NewClient()
+		builder, sess := newBuilder(mockWS)
+		builder.TextInput("Name")
+		widgetID := builder.generatePageID(state.WidgetTypeTextInput, []int{0})
+
+		if err := builder.Navigate("/customers/:id", map[string]string{"id": "42"}); err != nil {
+			t.Fatalf("Navigate returned error: %v", err)
+		}
+
+		// Nothing changes until the handler returns.
+		if sess.PageID != fromID {
+			t.Errorf("session page ID before the handler returned = %s, want %s", sess.PageID, fromID)
+		}
+		if sess.State.GetTextInput(widgetID) == nil {
+			t.Error("TextInput state was reset before the handler returned")
+		}
+		if len(mockWS.Messages()) != 1 {
+			t.Errorf("WebSocket messages count before the handler returned = %d, want 1", len(mockWS.Messages()))
+		}
+
+		builder.runtime.applyNavigation(sess)
+
+		if sess.PendingNavigation != nil {
+			t.Error("PendingNavigation was not cleared")
+		}
+		if sess.PageID != toID {
+			t.Errorf("session page ID = %s, want %s", sess.PageID, toID)
+		}
+		if sess.Params["id"] != "42" {
+			t.Errorf("session param id = %q, want %q", sess.Params["id"], "42")
+		}
+		if sess.State.GetTextInput(widgetID) != nil {
+			t.Error("TextInput state was not reset")
+		}
+
+		messages := mockWS.Messages()
+		msg := messages[len(messages)-1].GetNavigatePage()
+		if msg == nil {
+			t.Fatal("WebSocket message type = nil, want NavigatePage")
+		}
+		if msg.PageId != toID.String() {
+			t.Errorf("NavigatePage.PageId = %s, want %s", msg.PageId, toID)
+		}
+		if msg.UrlPath != "/customers/42" {
+			t.Errorf("NavigatePage.UrlPath = %q, want %q", msg.UrlPath, "/customers/42")
+		}
+		if msg.KeepState {
+			t.Error("NavigatePage.KeepState = true, want false")
+		}
+	})
+
+	t.Run("KeepState", func(t *testing.T) {
+		mockWS := mock.NewClient()
+		builder, sess := newBuilder(mockWS)
+		builder.TextInput("Name")
+		widgetID := builder.generatePageID(state.WidgetTypeTextInput, []int{0})
+
+		if err := builder.Navigate("/customers/:id", map[string]string{"id": "42"}, navigate.WithKeepState(true)); err != nil {
+			t.Fatalf("Navigate returned error: %v", err)
+		}
+		builder.runtime.applyNavigation(sess)
+
+		if sess.State.GetTextInput(widgetID) == nil {
+			t.Error("TextInput state was reset")
+		}
+		messages := mockWS.Messages()
+		if msg := messages[len(messages)-1].GetNavigatePage(); msg == nil || !msg.KeepState {
+			t.Errorf("last WebSocket message = %v, want NavigatePage with KeepState", messages[len(messages)-1])
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		builder, sess := newBuilder(mock.NewClient())
+
+		if err := builder.Navigate("/orders", nil); err == nil {
+			t.Error("Navigate to unknown route returned nil error")
+		}
+		if err := builder.Navigate("/customers/:id", nil); err == nil {
+			t.Error("Navigate without route params returned nil error")
+		}
+		if err := builder.Navigate("/admin", nil); err == nil {
+			t.Error("Navigate without access returned nil error")
+		}
+		if sess.PendingNavigation != nil {
+			t.Errorf("PendingNavigation = %+v, want nil after failed calls", sess.PendingNavigation)
+		}
+
+		sess.UserGroups = []string{"admin"}
+		if err := builder.Navigate("/admin", nil); err != nil {
+			t.Errorf("Navigate with access returned error: %v", err)
+		}
+	})
+}
+
+func TestRuntime_NavigateAfterHandler(t *testing.T) {
+	fromID := uuid.Must(uuid.NewV4())
+	toID := uuid.Must(uuid.NewV4())
+	sessionID := uuid.Must(uuid.NewV4())
+
+	var pageIDDuringRun uuid.UUID
+	fail := false
+	pages := map[uuid.UUID]*page{
+		fromID: {
+			id:    fromID,
+			name:  "Customers",
+			route: "/customers",
+			handler: func(ui UIBuilder) error {
+				if err := ui.Navigate("/customers/:id", map[string]string{"id": "7"}); err != nil {
+					return err
+				}
+				pageIDDuringRun = ui.(*uiBuilder).session.PageID
+				ui.Markdown("still rendering")
+				if fail {
+					return errors.New("handler failed")
+				}
+				return nil
+			},
+		},
+		toID: {id: toID, name: "Customer", route: "/customers/:id", handler: func(UIBuilder) error { return nil }},
+	}
+
+	mockClient := mock.NewClient()
+	r := &runtime{
+		wsClient:       mockClient,
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(pages),
+	}
+	sess := session.New(sessionID, fromID)
+	r.sessionManager.SetSession(sess)
+
+	if err := r.handleRerunPage(&websocketv1.RerunPage{
+		SessionId: sessionID.String(),
+		PageId:    fromID.String(),
+	}); err != nil {
+		t.Fatalf("handleRerunPage returned error: %v", err)
+	}
+
+	if pageIDDuringRun != fromID {
+		t.Errorf("session page ID during the run = %s, want %s", pageIDDuringRun, fromID)
+	}
+	if sess.PageID != toID {
+		t.Errorf("session page ID after the run = %s, want %s", sess.PageID, toID)
+	}
+	messages := mockClient.Messages()
+	if messages[len(messages)-2].GetScriptFinished() == nil {
+		t.Error("NavigatePage was not sent after ScriptFinished")
+	}
+	if msg := messages[len(messages)-1].GetNavigatePage(); msg == nil || msg.UrlPath != "/customers/7" {
+		t.Errorf("last WebSocket message = %v, want NavigatePage to /customers/7", messages[len(messages)-1])
+	}
+
+	// A failed run drops its navigation.
+	fail = true
+	sess.PageID = fromID
+	sess.Params = map[string]string{}
+	if err := r.handleRerunPage(&websocketv1.RerunPage{
+		SessionId: sessionID.String(),
+		PageId:    fromID.String(),
+	}); err == nil {
+		t.Fatal("handleRerunPage with failing handler returned nil error")
+	}
+	if sess.PageID != fromID {
+		t.Errorf("session page ID after a failed run = %s, want %s", sess.PageID, fromID)
+	}
+	if sess.PendingNavigation != nil {
+		t.Error("PendingNavigation was kept after a failed run")
+	}
+}
@@ -1,6 +1,10 @@
 package sourcetool
 
 import (
+	"math"
+	"slices"
+	"strconv"
+
 	"github.com/gofrs/uuid/v5"
 
 	"github.com/trysourcetool/sourcetool-go/internal/options"
@@ -19,12 +23,18 @@ func (b *uiBuilder) NumberInput(label string, opts ...numberinput.Option) *float
 		Disabled:     false,
 		MaxValue:     nil,
 		MinValue:     nil,
+		Step:         nil,
+		Precision:    nil,
 	}
 
 	for _, o := range opts {
 		o.Apply(numberInputOpts)
 	}
 
+	if numberInputOpts.Precision != nil {
+		numberInputOpts.DefaultValue = roundToPrecision(numberInputOpts.DefaultValue, *numberInputOpts.Precision)
+	}
+
 	sess := b.session
 	if sess == nil {
 		return nil
@@ -46,6 +56,14 @@ func (b *uiBuilder) NumberInput(label string, opts ...numberinput.Option) *float
 			ID:    widgetID,
 			Value: numberInputOpts.DefaultValue,
 		}
+		if v, ok := b.lookupQueryParam(numberInputOpts.QueryParam); ok {
+			if f, err := strconv.ParseFloat(v[0], 64); err == nil {
+				numberInputState.Value = &f
+			}
+		}
+	}
+	if numberInputOpts.Precision != nil {
+		numberInputState.Value = roundToPrecision(numberInputState.Value, *numberInputOpts.Precision)
 	}
 	numberInputState.Label = numberInputOpts.Label
 	numberInputState.Placeholder = numberInputOpts.Placeholder
@@ -54,8 +72,13 @@ func (b *uiBuilder) NumberInput(label string, opts ...numberinput.Option) *float
 	numberInputState.Disabled = numberInputOpts.Disabled
 	numberInputState.MaxValue = numberInputOpts.MaxValue
 	numberInputState.MinValue = numberInputOpts.MinValue
+	numberInputState.Step = numberInputOpts.Step
+	numberInputState.Precision = numberInputOpts.Precision
 	sess.State.Set(widgetID, numberInputState)
 
+	values := formatFloatParam(numberInputState.Value)
+	b.bindQueryParam(numberInputOpts.QueryParam, values, slices.Equal(values, formatFloatParam(numberInputOpts.DefaultValue)))
+
 	numberInput := convertStateToNumberInputProto(numberInputState)
 	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
 		SessionId: sess.ID.String(),
@@ -74,10 +97,31 @@ func (b *uiBuilder) NumberInput(label string, opts ...numberinput.Option) *float
 	return numberInputState.Value
 }
 
+func formatFloatParam(v *float64) []string {
+	if v == nil {
+		return nil
+	}
+	return []string{strconv.FormatFloat(*v, 'f', -1, 64)}
+}
+
+func roundToPrecision(v *float64, precision int) *float64 {
+	if v == nil {
+		return nil
+	}
+	scale := math.Pow10(precision)
+	rounded := math.Round(*v*scale) / scale
+	return &rounded
+}
+
 func convertStateToNumberInputProto(state *state.NumberInputState) *widgetv1.NumberInput {
 	if state == nil {
 		return nil
 	}
+	var precision *int32
+	if state.Precision != nil {
+		p := int32(*state.Precision)
+		precision = &p
+	}
 	return &widgetv1.NumberInput{
 		Value:        state.Value,
 		Label:        state.Label,
@@ -87,6 +131,8 @@ func convertStateToNumberInputProto(state *state.NumberInputState) *widgetv1.Num
 		Disabled:     state.Disabled,
 		MaxValue:     state.MaxValue,
 		MinValue:     state.MinValue,
+		Step:         state.Step,
+		Precision:    precision,
 	}
 }
 
@@ -94,6 +140,11 @@ func convertNumberInputProtoToState(id uuid.UUID, data *widgetv1.NumberInput) *s
 	if data == nil {
 		return nil
 	}
+	var precision *int
+	if data.Precision != nil {
+		p := int(*data.Precision)
+		precision = &p
+	}
 	return &state.NumberInputState{
 		ID:           id,
 		Value:        data.Value,
@@ -104,5 +155,7 @@ func convertNumberInputProtoToState(id uuid.UUID, data *widgetv1.NumberInput) *s
 		Disabled:     data.Disabled,
 		MaxValue:     data.MaxValue,
 		MinValue:     data.MinValue,
+		Step:         data.Step,
+		Precision:    precision,
 	}
 }
@@ -65,3 +65,36 @@ func (m minValueOption) Apply(opts *options.NumberInputOptions) {
 func WithMinValue(value float64) Option {
 	return minValueOption(value)
 }
+
+type stepOption float64
+
+func (s stepOption) Apply(opts *options.NumberInputOptions) {
+	opts.Step = (*float64)(&s)
+}
+
+func WithStep(step float64) Option {
+	return stepOption(step)
+}
+
+type precisionOption int
+
+func (p precisionOption) Apply(opts *options.NumberInputOptions) {
+	opts.Precision = (*int)(&p)
+}
+
+// WithPrecision rounds the value to the given number of decimal places.
+func WithPrecision(precision int) Option {
+	return precisionOption(precision)
+}
+
+type queryParamOption string
+
+func (q queryParamOption) Apply(opts *options.NumberInputOptions) {
+	opts.QueryParam = string(q)
+}
+
+// WithQueryParam binds the value to the key query parameter of the page URL.
+// The parameter sets the initial value and is updated when the value changes.
+func WithQueryParam(key string) Option {
+	return queryParamOption(key)
+}
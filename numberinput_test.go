@@ -188,3 +188,73 @@ func TestNumberInput(t *testing.T) {
 		})
 	}
 }
+
+func TestNumberInput_StepAndPrecision(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeNumberInput, []int{0})
+	sent := 12.3456
+	sess.State.Set(widgetID, &state.NumberInputState{
+		ID:    widgetID,
+		Value: &sent,
+	})
+
+	value := builder.NumberInput("Price",
+		numberinput.WithStep(0.01),
+		numberinput.WithPrecision(2),
+	)
+
+	if value == nil {
+		t.Fatal("NumberInput returned nil")
+	}
+	if *value != 12.35 {
+		t.Errorf("NumberInput value = %v, want 12.35", *value)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	data := messages[0].GetRenderWidget().Widget.GetNumberInput()
+	if data == nil {
+		t.Fatal("RenderWidget widget type = nil, want NumberInput")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Step", data.GetStep(), 0.01},
+		{"Precision", data.GetPrecision(), int32(2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	state := convertNumberInputProtoToState(widgetID, data)
+	if state.Precision == nil || *state.Precision != 2 {
+		t.Errorf("converted Precision = %v, want 2", state.Precision)
+	}
+}
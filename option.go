@@ -0,0 +1,73 @@
+package sourcetool
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+)
+
+type Option interface {
+	Apply(*options.SourcetoolOptions)
+}
+
+type pingIntervalOption time.Duration
+
+func (p pingIntervalOption) Apply(opts *options.SourcetoolOptions) {
+	opts.PingInterval = time.Duration(p)
+}
+
+// WithPingInterval sets how often the websocket connection is pinged, between
+// 100ms and 30s.
+func WithPingInterval(interval time.Duration) Option {
+	return pingIntervalOption(interval)
+}
+
+type reconnectDelayOption time.Duration
+
+func (r reconnectDelayOption) Apply(opts *options.SourcetoolOptions) {
+	opts.ReconnectDelay = time.Duration(r)
+}
+
+// WithReconnectDelay sets the delay before reconnecting a dropped connection.
+// It must be at least 100ms.
+func WithReconnectDelay(delay time.Duration) Option {
+	return reconnectDelayOption(delay)
+}
+
+type queueSizeOption int
+
+func (q queueSizeOption) Apply(opts *options.SourcetoolOptions) {
+	opts.QueueSize = int(q)
+}
+
+// WithQueueSize sets how many outgoing messages can be buffered, between 50
+// and 1000.
+func WithQueueSize(size int) Option {
+	return queueSizeOption(size)
+}
+
+type loggerOption struct {
+	logger *zap.Logger
+}
+
+func (l loggerOption) Apply(opts *options.SourcetoolOptions) {
+	opts.Logger = l.logger
+}
+
+// WithLogger replaces the default production logger.
+func WithLogger(logger *zap.Logger) Option {
+	return loggerOption{logger: logger}
+}
+
+type locationOption time.Location
+
+func (l locationOption) Apply(opts *options.SourcetoolOptions) {
+	opts.Location = (*time.Location)(&l)
+}
+
+// WithLocation sets the default time zone of date and time widgets.
+func WithLocation(location time.Location) Option {
+	return locationOption(location)
+}
@@ -1,10 +1,15 @@
 package sourcetool
 
 import (
+	"maps"
+	"net/url"
 	"slices"
+	"strings"
 	"sync"
 
 	"github.com/gofrs/uuid/v5"
+
+	pagev1 "github.com/trysourcetool/sourcetool-go/internal/pb/page/v1"
 )
 
 type page struct {
@@ -14,15 +19,85 @@ type page struct {
 	path         []int
 	handler      func(UIBuilder) error
 	accessGroups []string
+	middlewares  []Middleware
+	icon         string
+	description  string
+	order        *int
+	hidden       bool
+	groupLabel   string
 }
 
 func (p *page) run(ui UIBuilder) error {
-	if err := p.handler(ui); err != nil {
+	handler := PageHandler(p.handler)
+	for i := len(p.middlewares) - 1; i >= 0; i-- {
+		handler = p.middlewares[i](handler)
+	}
+	if err := handler(ui); err != nil {
 		return err
 	}
 	return nil
 }
 
+// routeParams returns the parameter names of a route pattern such as
+// "/customers/:id" or "/files/*rest", in the order they appear.
+func routeParams(route string) []string {
+	var params []string
+	for _, seg := range strings.Split(route, "/") {
+		if strings.HasPrefix(seg, ":") || strings.HasPrefix(seg, "*") {
+			params = append(params, seg[1:])
+		}
+	}
+	return params
+}
+
+// matchPath matches a concrete URL path against the page route. A ":name"
+// segment matches exactly one segment and a trailing "*name" segment matches
+// the rest of the path, which may be empty.
+func (p *page) matchPath(urlPath string) (map[string]string, bool) {
+	routeSegs := strings.Split(strings.Trim(p.route, "/"), "/")
+	pathSegs := strings.Split(strings.Trim(urlPath, "/"), "/")
+	params := make(map[string]string)
+
+	for i, seg := range routeSegs {
+		if strings.HasPrefix(seg, "*") {
+			rest := make([]string, 0, len(pathSegs))
+			if i < len(pathSegs) {
+				for _, s := range pathSegs[i:] {
+					v, err := url.PathUnescape(s)
+					if err != nil {
+						return nil, false
+					}
+					rest = append(rest, v)
+				}
+			}
+			params[seg[1:]] = strings.Join(rest, "/")
+			return params, true
+		}
+		if i >= len(pathSegs) {
+			return nil, false
+		}
+		v, err := url.PathUnescape(pathSegs[i])
+		if err != nil {
+			return nil, false
+		}
+		if strings.HasPrefix(seg, ":") {
+			if v == "" {
+				return nil, false
+			}
+			params[seg[1:]] = v
+			continue
+		}
+		if seg != v {
+			return nil, false
+		}
+	}
+
+	if len(pathSegs) != len(routeSegs) {
+		return nil, false
+	}
+	return params, true
+}
+
 func (p *page) hasAccess(userGroups []string) bool {
 	if len(p.accessGroups) == 0 {
 		return true
@@ -52,3 +127,79 @@ func (s *pageManager) getPage(id uuid.UUID) *page {
 	defer s.mu.RUnlock()
 	return s.pages[id]
 }
+
+// getPages returns the registered pages ordered by their navigation path.
+func (s *pageManager) getPages() []*page {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return sortPagesByPath(s.pages)
+}
+
+func sortPagesByPath(pages map[uuid.UUID]*page) []*page {
+	sorted := slices.Collect(maps.Values(pages))
+	slices.SortFunc(sorted, func(a, b *page) int {
+		return slices.Compare(a.path, b.path)
+	})
+	return sorted
+}
+
+func (s *pageManager) setPage(p *page) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pages[p.id] = p
+}
+
+func (s *pageManager) removePage(id uuid.UUID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.pages, id)
+}
+
+// syncPages replaces the registered pages with pages and reports whether
+// the set of page IDs changed.
+func (s *pageManager) syncPages(pages map[uuid.UUID]*page) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	changed := len(pages) != len(s.pages)
+	for id := range pages {
+		if _, ok := s.pages[id]; !ok {
+			changed = true
+		}
+	}
+	s.pages = pages
+	return changed
+}
+
+func (s *pageManager) getPageByRoute(route string) *page {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for _, p := range s.pages {
+		if p.route == route {
+			return p
+		}
+	}
+	return nil
+}
+
+func convertPageToProto(p *page) *pagev1.Page {
+	params := routeParams(p.route)
+	var order *int32
+	if p.order != nil {
+		o := int32(*p.order)
+		order = &o
+	}
+	return &pagev1.Page{
+		Id:            p.id.String(),
+		Name:          p.name,
+		Route:         p.route,
+		Path:          convertPathToInt32Slice(p.path),
+		Groups:        p.accessGroups,
+		Parameterized: len(params) > 0,
+		Params:        params,
+		Icon:          p.icon,
+		Description:   p.description,
+		Order:         order,
+		Hidden:        p.hidden,
+		GroupLabel:    p.groupLabel,
+	}
+}
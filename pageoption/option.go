@@ -0,0 +1,76 @@
+package pageoption
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.PageOptions)
+}
+
+type idOption string
+
+func (i idOption) Apply(opts *options.PageOptions) {
+	opts.ID = string(i)
+}
+
+// WithID sets the page ID to the given UUID instead of deriving it from the
+// namespace, environment and route, so the ID survives route changes.
+func WithID(id string) Option {
+	return idOption(id)
+}
+
+type iconOption string
+
+func (i iconOption) Apply(opts *options.PageOptions) {
+	opts.Icon = string(i)
+}
+
+// WithIcon sets the icon shown next to the page in the navigation.
+func WithIcon(icon string) Option {
+	return iconOption(icon)
+}
+
+type descriptionOption string
+
+func (d descriptionOption) Apply(opts *options.PageOptions) {
+	opts.Description = string(d)
+}
+
+func WithDescription(description string) Option {
+	return descriptionOption(description)
+}
+
+type orderOption int
+
+func (o orderOption) Apply(opts *options.PageOptions) {
+	order := int(o)
+	opts.Order = &order
+}
+
+// WithOrder sets the position of the page in the navigation. Pages without an
+// order are listed after ordered pages, in registration order.
+func WithOrder(order int) Option {
+	return orderOption(order)
+}
+
+type hiddenOption bool
+
+func (h hiddenOption) Apply(opts *options.PageOptions) {
+	opts.Hidden = bool(h)
+}
+
+// WithHidden keeps the page out of the navigation. It can still be opened by
+// its route or with Navigate.
+func WithHidden(hidden bool) Option {
+	return hiddenOption(hidden)
+}
+
+type groupLabelOption string
+
+func (g groupLabelOption) Apply(opts *options.PageOptions) {
+	opts.GroupLabel = string(g)
+}
+
+// WithGroupLabel puts the page under a labeled section of the navigation.
+func WithGroupLabel(label string) Option {
+	return groupLabelOption(label)
+}
@@ -0,0 +1,127 @@
+package sourcetool
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/gofrs/uuid/v5"
+
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+// ErrRunFinished is returned when a widget handle such as Progress or
+// Spinner is updated after the page handler that rendered it has returned.
+var ErrRunFinished = errors.New("page run has finished")
+
+// Progress is a handle to a progress bar rendered by UIBuilder.Progress.
+// Update re-renders the bar in place, so it can be called repeatedly while
+// the page handler is still running.
+type Progress struct {
+	builder *uiBuilder
+	path    path
+	state   *state.ProgressState
+	mu      sync.Mutex
+}
+
+func (b *uiBuilder) Progress(label string) *Progress {
+	sess := b.session
+	if sess == nil {
+		return &Progress{}
+	}
+	page := b.page
+	if page == nil {
+		return &Progress{}
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return &Progress{}
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeProgress, path)
+	progressState := sess.State.GetProgress(widgetID)
+	if progressState == nil {
+		progressState = &state.ProgressState{
+			ID: widgetID,
+		}
+	}
+	progressState.Label = label
+	progressState.Value = 0
+	progressState.Text = ""
+	sess.State.Set(widgetID, progressState)
+
+	p := &Progress{
+		builder: b,
+		path:    path,
+		state:   progressState,
+	}
+	p.render()
+
+	cursor.next()
+
+	return p
+}
+
+// Update sets the progress to pct, clamped to [0, 100], and replaces the
+// text shown next to the bar. It returns ErrRunFinished once the page
+// handler has returned, since the page may have been rerun since.
+func (p *Progress) Update(pct float64, text string) error {
+	if p.builder == nil {
+		return nil
+	}
+	if p.builder.runFinished() {
+		return ErrRunFinished
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.state.Value = min(max(pct, 0), 100)
+	p.state.Text = text
+	p.builder.session.State.Set(p.state.ID, p.state)
+
+	p.render()
+
+	return nil
+}
+
+func (p *Progress) render() {
+	b := p.builder
+	progress := convertStateToProgressProto(p.state)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: b.session.ID.String(),
+		PageId:    b.page.id.String(),
+		Path:      convertPathToInt32Slice(p.path),
+		Widget: &widgetv1.Widget{
+			Id: p.state.ID.String(),
+			Type: &widgetv1.Widget_Progress{
+				Progress: progress,
+			},
+		},
+	})
+}
+
+func convertStateToProgressProto(state *state.ProgressState) *widgetv1.Progress {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Progress{
+		Label: state.Label,
+		Value: state.Value,
+		Text:  state.Text,
+	}
+}
+
+func convertProgressProtoToState(id uuid.UUID, data *widgetv1.Progress) *state.ProgressState {
+	if data == nil {
+		return nil
+	}
+	return &state.ProgressState{
+		ID:    id,
+		Label: data.Label,
+		Value: data.Value,
+		Text:  data.Text,
+	}
+}
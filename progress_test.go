@@ -0,0 +1,197 @@
+package sourcetool
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToProgressProto(t *testing.T) {
+	progressState := &state.ProgressState{
+		ID:    uuid.Must(uuid.NewV4()),
+		Label: "Exporting",
+		Value: 42,
+		Text:  "42 of 100 rows",
+	}
+
+	data := convertStateToProgressProto(progressState)
+
+	if data == nil {
+		t.Fatal("convertStateToProgressProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", data.Label, progressState.Label},
+		{"Value", data.Value, progressState.Value},
+		{"Text", data.Text, progressState.Text},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertProgressProtoToState(t *testing.T) {
+	data := &widgetv1.Progress{
+		Label: "Exporting",
+		Value: 42,
+		Text:  "42 of 100 rows",
+	}
+
+	state := convertProgressProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertProgressProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, data.Label},
+		{"Value", state.Value, data.Value},
+		{"Text", state.Text, data.Text},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgress(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	progress := builder.Progress("Exporting")
+	builder.Markdown("after")
+	progress.Update(50, "Halfway")
+	progress.Update(150, "Done")
+
+	messages := mockWS.Messages()
+	if len(messages) != 4 {
+		t.Fatalf("WebSocket messages count = %d, want 4", len(messages))
+	}
+
+	first := messages[0].GetRenderWidget()
+	last := messages[3].GetRenderWidget()
+	if first == nil || last == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+	if first.Widget.Id != last.Widget.Id {
+		t.Errorf("updated widget ID = %v, want %v", last.Widget.Id, first.Widget.Id)
+	}
+	if len(last.Path) != 1 || last.Path[0] != 0 {
+		t.Errorf("updated widget path = %v, want [0]", last.Path)
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeProgress, []int{0})
+	state := sess.State.GetProgress(widgetID)
+	if state == nil {
+		t.Fatal("Progress state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, "Exporting"},
+		{"Value", state.Value, float64(100)},
+		{"Text", state.Text, "Done"},
+		{"ProtoValue", last.Widget.GetProgress().Value, float64(100)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgress_NilSession(t *testing.T) {
+	builder := &uiBuilder{
+		context: context.Background(),
+		cursor:  newCursor(),
+	}
+
+	progress := builder.Progress("Exporting")
+	if progress == nil {
+		t.Fatal("Progress returned nil")
+	}
+	if err := progress.Update(10, "no-op"); err != nil {
+		t.Errorf("Update() error = %v, want nil", err)
+	}
+}
+
+func TestProgress_AfterRunFinished(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	builder := &uiBuilder{
+		context: ctx,
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	progress := builder.Progress("Exporting")
+	cancel()
+
+	if err := progress.Update(50, "Halfway"); !errors.Is(err, ErrRunFinished) {
+		t.Errorf("Update() error = %v, want %v", err, ErrRunFinished)
+	}
+	if len(mockWS.Messages()) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(mockWS.Messages()))
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeProgress, []int{0})
+	if got := sess.State.GetProgress(widgetID).Value; got != 0 {
+		t.Errorf("Value = %v, want 0", got)
+	}
+}
@@ -0,0 +1,86 @@
+package sourcetool
+
+import (
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+)
+
+// QueryParams returns the query string parameters of the page URL.
+func (b *uiBuilder) QueryParams() url.Values {
+	params := url.Values{}
+	if b.session == nil {
+		return params
+	}
+	for k, v := range b.session.QueryParams {
+		params[k] = slices.Clone(v)
+	}
+	return params
+}
+
+// SetQueryParams replaces the query string of the page URL without rerunning the page.
+func (b *uiBuilder) SetQueryParams(params url.Values) {
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+
+	queryParams := url.Values{}
+	for k, v := range params {
+		queryParams[k] = slices.Clone(v)
+	}
+	sess.QueryParams = queryParams
+
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.SetQueryParams{
+		SessionId:   sess.ID.String(),
+		PageId:      page.id.String(),
+		QueryString: queryParams.Encode(),
+	})
+}
+
+// formatTimeParam formats a bound time value in location with layout.
+func formatTimeParam(v *time.Time, layout string, location *time.Location) []string {
+	if v == nil {
+		return nil
+	}
+	return []string{v.In(location).Format(layout)}
+}
+
+// lookupQueryParam returns the values of key when a widget is bound to it.
+func (b *uiBuilder) lookupQueryParam(key string) ([]string, bool) {
+	if key == "" || b.session == nil {
+		return nil, false
+	}
+	values, ok := b.session.QueryParams[key]
+	return values, ok && len(values) > 0
+}
+
+// bindQueryParam writes the value of a bound widget to the query string.
+// Values equal to the widget default are removed to keep URLs short.
+func (b *uiBuilder) bindQueryParam(key string, values []string, isDefault bool) {
+	if key == "" || b.session == nil {
+		return
+	}
+	if isDefault {
+		values = nil
+	}
+	if slices.Equal(b.session.QueryParams[key], values) {
+		return
+	}
+
+	params := b.QueryParams()
+	if len(values) == 0 {
+		params.Del(key)
+	} else {
+		params[key] = values
+	}
+	b.SetQueryParams(params)
+}
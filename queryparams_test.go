@@ -0,0 +1,218 @@
+package sourcetool
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/checkbox"
+	"github.com/trysourcetool/sourcetool-go/checkboxgroup"
+	"github.com/trysourcetool/sourcetool-go/datetimeinput"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/multiselect"
+	"github.com/trysourcetool/sourcetool-go/radio"
+	"github.com/trysourcetool/sourcetool-go/selectbox"
+	"github.com/trysourcetool/sourcetool-go/textarea"
+	"github.com/trysourcetool/sourcetool-go/textinput"
+	"github.com/trysourcetool/sourcetool-go/timeinput"
+)
+
+func newQueryParamsTestBuilder(query string, wsClient websocket.Client) (*uiBuilder, *session.Session) {
+	sess := session.New(uuid.Must(uuid.NewV4()), uuid.Must(uuid.NewV4()))
+	sess.QueryParams, _ = url.ParseQuery(query)
+	return &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: sess.PageID,
+		},
+		runtime: &runtime{
+			wsClient: wsClient,
+		},
+	}, sess
+}
+
+func TestSetQueryParams(t *testing.T) {
+	mockWS := mock.NewClient()
+	builder, sess := newQueryParamsTestBuilder("q=foo", mockWS)
+
+	params := builder.QueryParams()
+	if got := params.Get("q"); got != "foo" {
+		t.Errorf("QueryParams().Get(q) = %q, want %q", got, "foo")
+	}
+
+	// Modifying the returned values must not affect the session.
+	params.Set("q", "bar")
+	if got := sess.QueryParams.Get("q"); got != "foo" {
+		t.Errorf("session query param q = %q, want %q", got, "foo")
+	}
+
+	builder.SetQueryParams(params)
+	if got := sess.QueryParams.Get("q"); got != "bar" {
+		t.Errorf("session query param q after SetQueryParams = %q, want %q", got, "bar")
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	msg := messages[0].GetSetQueryParams()
+	if msg == nil {
+		t.Fatal("WebSocket message type = nil, want SetQueryParams")
+	}
+	if msg.QueryString != "q=bar" {
+		t.Errorf("SetQueryParams.QueryString = %q, want %q", msg.QueryString, "q=bar")
+	}
+}
+
+func TestTextInput_WithQueryParam(t *testing.T) {
+	mockWS := mock.NewClient()
+	builder, sess := newQueryParamsTestBuilder("q=foo", mockWS)
+
+	value := builder.TextInput("Search", textinput.WithQueryParam("q"))
+	if value != "foo" {
+		t.Errorf("TextInput value = %q, want %q", value, "foo")
+	}
+	if n := len(mockWS.Messages()); n != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", n)
+	}
+
+	// Changing the value in the browser writes it back to the query string.
+	widgetID := builder.generatePageID(state.WidgetTypeTextInput, []int{0})
+	newValue := "bar"
+	sess.State.GetTextInput(widgetID).Value = &newValue
+
+	builder.cursor = newCursor()
+	builder.TextInput("Search", textinput.WithQueryParam("q"))
+	if got := sess.QueryParams.Get("q"); got != "bar" {
+		t.Errorf("query param q = %q, want %q", got, "bar")
+	}
+	var queryString string
+	for _, msg := range mockWS.Messages() {
+		if v := msg.GetSetQueryParams(); v != nil {
+			queryString = v.QueryString
+		}
+	}
+	if queryString != "q=bar" {
+		t.Errorf("SetQueryParams.QueryString = %q, want %q", queryString, "q=bar")
+	}
+
+	// Resetting to the default removes the parameter.
+	emptyValue := ""
+	sess.State.GetTextInput(widgetID).Value = &emptyValue
+
+	builder.cursor = newCursor()
+	builder.TextInput("Search", textinput.WithQueryParam("q"))
+	if sess.QueryParams.Has("q") {
+		t.Errorf("query param q = %q, want removed", sess.QueryParams.Get("q"))
+	}
+}
+
+func TestWidgets_WithQueryParam(t *testing.T) {
+	builder, _ := newQueryParamsTestBuilder("done=true&status=closed&tags=b&tags=new", mock.NewClient())
+
+	checked := builder.Checkbox("Done", checkbox.WithQueryParam("done"))
+	status := builder.Selectbox("Status",
+		selectbox.WithOptions("open", "closed"),
+		selectbox.WithQueryParam("status"),
+	)
+	tags := builder.MultiSelect("Tags",
+		multiselect.WithOptions("a", "b"),
+		multiselect.WithCreatable(true),
+		multiselect.WithQueryParam("tags"),
+	)
+
+	if !checked {
+		t.Error("Checkbox value = false, want true")
+	}
+	if status == nil || status.Value != "closed" || status.Index != 1 {
+		t.Errorf("Selectbox value = %+v, want closed at index 1", status)
+	}
+	if tags == nil || len(tags.Values) != 2 || tags.Values[0] != "b" || tags.Values[1] != "new" {
+		t.Errorf("MultiSelect value = %+v, want [b new]", tags)
+	}
+}
+
+func TestMoreWidgets_WithQueryParam(t *testing.T) {
+	builder, _ := newQueryParamsTestBuilder(
+		"note=hi&size=m&days=tue&days=fri&days=sun&at=09%3A30%3A00&from=2025-03-15+18%3A30%3A00",
+		mock.NewClient(),
+	)
+	builder.runtime.location = time.UTC
+
+	note := builder.TextArea("Note", textarea.WithQueryParam("note"))
+	size := builder.Radio("Size",
+		radio.WithOptions("s", "m", "l"),
+		radio.WithQueryParam("size"),
+	)
+	days := builder.CheckboxGroup("Days",
+		checkboxgroup.WithOptions("mon", "tue", "fri"),
+		checkboxgroup.WithQueryParam("days"),
+	)
+	at := builder.TimeInput("At", timeinput.WithQueryParam("at"))
+	from := builder.DateTimeInput("From", datetimeinput.WithQueryParam("from"))
+
+	if note != "hi" {
+		t.Errorf("TextArea value = %q, want %q", note, "hi")
+	}
+	if size == nil || size.Value != "m" || size.Index != 1 {
+		t.Errorf("Radio value = %+v, want m at index 1", size)
+	}
+	if days == nil || !slices.Equal(days.Values, []string{"tue", "fri"}) {
+		t.Errorf("CheckboxGroup value = %+v, want [tue fri] without unknown options", days)
+	}
+	if at == nil || at.Format(time.TimeOnly) != "09:30:00" {
+		t.Errorf("TimeInput value = %v, want 09:30:00", at)
+	}
+	if want := time.Date(2025, 3, 15, 18, 30, 0, 0, time.UTC); from == nil || !from.Equal(want) {
+		t.Errorf("DateTimeInput value = %v, want %v", from, want)
+	}
+}
+
+func TestCheckboxGroup_WithQueryParam(t *testing.T) {
+	builder, sess := newQueryParamsTestBuilder("", mock.NewClient())
+	opts := []checkboxgroup.Option{
+		checkboxgroup.WithOptions("mon", "tue", "fri"),
+		checkboxgroup.WithDefaultValue("mon"),
+		checkboxgroup.WithQueryParam("days"),
+	}
+
+	builder.CheckboxGroup("Days", opts...)
+	if sess.QueryParams.Has("days") {
+		t.Errorf("query param days = %v, want none for the default value", sess.QueryParams["days"])
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeCheckboxGroup, []int{0})
+	sess.State.GetCheckboxGroup(widgetID).Value = []int32{1, 2}
+	builder.cursor = newCursor()
+	builder.CheckboxGroup("Days", opts...)
+	if got := sess.QueryParams["days"]; !slices.Equal(got, []string{"tue", "fri"}) {
+		t.Errorf("query param days = %v, want [tue fri]", got)
+	}
+}
+
+func TestSelectbox_WithQueryParamAndSearch(t *testing.T) {
+	builder, sess := newQueryParamsTestBuilder("customer=evil", mock.NewClient())
+	search := func(ctx context.Context, query string) ([]selectbox.SearchResult, error) {
+		return nil, nil
+	}
+
+	value := builder.Selectbox("Customer",
+		selectbox.WithSearch(search),
+		selectbox.WithQueryParam("customer"),
+	)
+	if value != nil {
+		t.Errorf("Selectbox value = %+v, want nil for an unsearched URL value", value)
+	}
+	if got := sess.QueryParams.Get("customer"); got != "evil" {
+		t.Errorf("query param customer = %q, want it left untouched", got)
+	}
+}
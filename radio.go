@@ -1,6 +1,8 @@
 package sourcetool
 
 import (
+	"slices"
+
 	"github.com/gofrs/uuid/v5"
 
 	"github.com/trysourcetool/sourcetool-go/internal/options"
@@ -57,6 +59,12 @@ func (b *uiBuilder) Radio(label string, opts ...radio.Option) *radio.Value {
 			Value:        defaultVal,
 			DefaultValue: defaultVal,
 		}
+		if v, ok := b.lookupQueryParam(radioOpts.QueryParam); ok {
+			if idx := slices.Index(radioOpts.Options, v[0]); idx >= 0 {
+				i := int32(idx)
+				radioState.Value = &i
+			}
+		}
 	}
 
 	if radioOpts.FormatFunc == nil {
@@ -77,6 +85,15 @@ func (b *uiBuilder) Radio(label string, opts ...radio.Option) *radio.Value {
 	radioState.Disabled = radioOpts.Disabled
 	sess.State.Set(widgetID, radioState)
 
+	var values, defaultValues []string
+	if radioState.Value != nil {
+		values = []string{radioOpts.Options[*radioState.Value]}
+	}
+	if defaultVal != nil {
+		defaultValues = []string{radioOpts.Options[*defaultVal]}
+	}
+	b.bindQueryParam(radioOpts.QueryParam, values, slices.Equal(values, defaultValues))
+
 	radioProto := convertStateToRadioProto(radioState)
 	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
 		SessionId: sess.ID.String(),
@@ -57,3 +57,15 @@ func (f formatFuncOption) Apply(opts *options.RadioOptions) {
 func WithFormatFunc(formatFunc func(string, int) string) Option {
 	return formatFuncOption(formatFunc)
 }
+
+type queryParamOption string
+
+func (q queryParamOption) Apply(opts *options.RadioOptions) {
+	opts.QueryParam = string(q)
+}
+
+// WithQueryParam binds the value to the key query parameter of the page URL.
+// The parameter sets the initial value and is updated when the value changes.
+func WithQueryParam(key string) Option {
+	return queryParamOption(key)
+}
@@ -0,0 +1,131 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/rangeslider"
+)
+
+func (b *uiBuilder) RangeSlider(label string, opts ...rangeslider.Option) (float64, float64) {
+	rangeSliderOpts := &options.RangeSliderOptions{
+		Label:        label,
+		DefaultValue: nil,
+		MinValue:     0,
+		MaxValue:     100,
+		Step:         1,
+		Format:       "",
+		Disabled:     false,
+	}
+
+	for _, o := range opts {
+		o.Apply(rangeSliderOpts)
+	}
+	rangeSliderOpts.MinValue, rangeSliderOpts.MaxValue, rangeSliderOpts.Step = normalizeSliderBounds(rangeSliderOpts.MinValue, rangeSliderOpts.MaxValue, rangeSliderOpts.Step)
+
+	defaultLow, defaultHigh := rangeSliderOpts.MinValue, rangeSliderOpts.MaxValue
+	if rangeSliderOpts.DefaultValue != nil {
+		defaultLow, defaultHigh = normalizeRange(rangeSliderOpts.DefaultValue[0], rangeSliderOpts.DefaultValue[1], rangeSliderOpts.MinValue, rangeSliderOpts.MaxValue)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return defaultLow, defaultHigh
+	}
+	page := b.page
+	if page == nil {
+		return defaultLow, defaultHigh
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return defaultLow, defaultHigh
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeRangeSlider, path)
+	rangeSliderState := sess.State.GetRangeSlider(widgetID)
+	if rangeSliderState == nil {
+		rangeSliderState = &state.RangeSliderState{
+			ID:        widgetID,
+			LowValue:  defaultLow,
+			HighValue: defaultHigh,
+		}
+	}
+	rangeSliderState.LowValue, rangeSliderState.HighValue = normalizeRange(rangeSliderState.LowValue, rangeSliderState.HighValue, rangeSliderOpts.MinValue, rangeSliderOpts.MaxValue)
+	rangeSliderState.Label = rangeSliderOpts.Label
+	rangeSliderState.DefaultLowValue = defaultLow
+	rangeSliderState.DefaultHighValue = defaultHigh
+	rangeSliderState.MinValue = rangeSliderOpts.MinValue
+	rangeSliderState.MaxValue = rangeSliderOpts.MaxValue
+	rangeSliderState.Step = rangeSliderOpts.Step
+	rangeSliderState.Format = rangeSliderOpts.Format
+	rangeSliderState.Disabled = rangeSliderOpts.Disabled
+	sess.State.Set(widgetID, rangeSliderState)
+
+	rangeSlider := convertStateToRangeSliderProto(rangeSliderState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_RangeSlider{
+				RangeSlider: rangeSlider,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return rangeSliderState.LowValue, rangeSliderState.HighValue
+}
+
+// normalizeRange clamps both ends into [minValue, maxValue] and swaps them if they are reversed.
+func normalizeRange(low, high, minValue, maxValue float64) (float64, float64) {
+	low = clampFloat(low, minValue, maxValue)
+	high = clampFloat(high, minValue, maxValue)
+	if low > high {
+		return high, low
+	}
+	return low, high
+}
+
+func convertStateToRangeSliderProto(state *state.RangeSliderState) *widgetv1.RangeSlider {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.RangeSlider{
+		LowValue:         state.LowValue,
+		HighValue:        state.HighValue,
+		Label:            state.Label,
+		DefaultLowValue:  state.DefaultLowValue,
+		DefaultHighValue: state.DefaultHighValue,
+		MinValue:         state.MinValue,
+		MaxValue:         state.MaxValue,
+		Step:             state.Step,
+		Format:           state.Format,
+		Disabled:         state.Disabled,
+	}
+}
+
+func convertRangeSliderProtoToState(id uuid.UUID, data *widgetv1.RangeSlider) *state.RangeSliderState {
+	if data == nil {
+		return nil
+	}
+	return &state.RangeSliderState{
+		ID:               id,
+		LowValue:         data.LowValue,
+		HighValue:        data.HighValue,
+		Label:            data.Label,
+		DefaultLowValue:  data.DefaultLowValue,
+		DefaultHighValue: data.DefaultHighValue,
+		MinValue:         data.MinValue,
+		MaxValue:         data.MaxValue,
+		Step:             data.Step,
+		Format:           data.Format,
+		Disabled:         data.Disabled,
+	}
+}
@@ -0,0 +1,74 @@
+package rangeslider
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.RangeSliderOptions)
+}
+
+type defaultValueOption [2]float64
+
+func (d defaultValueOption) Apply(opts *options.RangeSliderOptions) {
+	opts.DefaultValue = (*[2]float64)(&d)
+}
+
+func WithDefaultValue(low, high float64) Option {
+	return defaultValueOption{low, high}
+}
+
+type minValueOption float64
+
+func (m minValueOption) Apply(opts *options.RangeSliderOptions) {
+	opts.MinValue = float64(m)
+}
+
+// WithMinValue sets the lowest selectable value. If it is greater than the
+// maximum, the two bounds are swapped.
+func WithMinValue(value float64) Option {
+	return minValueOption(value)
+}
+
+type maxValueOption float64
+
+func (m maxValueOption) Apply(opts *options.RangeSliderOptions) {
+	opts.MaxValue = float64(m)
+}
+
+// WithMaxValue sets the highest selectable value. If it is less than the
+// minimum, the two bounds are swapped.
+func WithMaxValue(value float64) Option {
+	return maxValueOption(value)
+}
+
+type stepOption float64
+
+func (s stepOption) Apply(opts *options.RangeSliderOptions) {
+	opts.Step = float64(s)
+}
+
+// WithStep sets the increment between selectable values. A step that is not
+// positive falls back to 1.
+func WithStep(step float64) Option {
+	return stepOption(step)
+}
+
+type formatOption string
+
+func (f formatOption) Apply(opts *options.RangeSliderOptions) {
+	opts.Format = string(f)
+}
+
+// WithFormat sets a printf-style format such as "$%.0f" used to display both ends of the range.
+func WithFormat(format string) Option {
+	return formatOption(format)
+}
+
+type disabledOption bool
+
+func (d disabledOption) Apply(opts *options.RangeSliderOptions) {
+	opts.Disabled = bool(d)
+}
+
+func WithDisabled(disabled bool) Option {
+	return disabledOption(disabled)
+}
@@ -0,0 +1,206 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/rangeslider"
+)
+
+func TestConvertStateToRangeSliderProto(t *testing.T) {
+	rangeSliderState := &state.RangeSliderState{
+		ID:               uuid.Must(uuid.NewV4()),
+		LowValue:         20,
+		HighValue:        80,
+		Label:            "Test RangeSlider",
+		DefaultLowValue:  0,
+		DefaultHighValue: 100,
+		MinValue:         0,
+		MaxValue:         100,
+		Step:             5,
+		Format:           "$%.0f",
+		Disabled:         true,
+	}
+
+	data := convertStateToRangeSliderProto(rangeSliderState)
+
+	if data == nil {
+		t.Fatal("convertStateToRangeSliderProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"LowValue", data.LowValue, rangeSliderState.LowValue},
+		{"HighValue", data.HighValue, rangeSliderState.HighValue},
+		{"Label", data.Label, rangeSliderState.Label},
+		{"DefaultLowValue", data.DefaultLowValue, rangeSliderState.DefaultLowValue},
+		{"DefaultHighValue", data.DefaultHighValue, rangeSliderState.DefaultHighValue},
+		{"MinValue", data.MinValue, rangeSliderState.MinValue},
+		{"MaxValue", data.MaxValue, rangeSliderState.MaxValue},
+		{"Step", data.Step, rangeSliderState.Step},
+		{"Format", data.Format, rangeSliderState.Format},
+		{"Disabled", data.Disabled, rangeSliderState.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertRangeSliderProtoToState(t *testing.T) {
+	data := &widgetv1.RangeSlider{
+		LowValue:         1,
+		HighValue:        3,
+		Label:            "Test RangeSlider",
+		DefaultLowValue:  0,
+		DefaultHighValue: 5,
+		MinValue:         0,
+		MaxValue:         5,
+		Step:             1,
+		Format:           "%.0f",
+		Disabled:         false,
+	}
+
+	state := convertRangeSliderProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertRangeSliderProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"LowValue", state.LowValue, data.LowValue},
+		{"HighValue", state.HighValue, data.HighValue},
+		{"Label", state.Label, data.Label},
+		{"DefaultLowValue", state.DefaultLowValue, data.DefaultLowValue},
+		{"DefaultHighValue", state.DefaultHighValue, data.DefaultHighValue},
+		{"MinValue", state.MinValue, data.MinValue},
+		{"MaxValue", state.MaxValue, data.MaxValue},
+		{"Step", state.Step, data.Step},
+		{"Format", state.Format, data.Format},
+		{"Disabled", state.Disabled, data.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeSlider(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	label := "Price"
+	low, high := builder.RangeSlider(label,
+		rangeslider.WithMinValue(0),
+		rangeslider.WithMaxValue(1000),
+		rangeslider.WithStep(10),
+		rangeslider.WithDefaultValue(500, 100),
+	)
+
+	if low != 100 || high != 500 {
+		t.Errorf("RangeSlider value = (%v, %v), want (100, 500)", low, high)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeRangeSlider, []int{0})
+	state := sess.State.GetRangeSlider(widgetID)
+	if state == nil {
+		t.Fatal("RangeSlider state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, label},
+		{"LowValue", state.LowValue, 100.0},
+		{"HighValue", state.HighValue, 500.0},
+		{"DefaultLowValue", state.DefaultLowValue, 100.0},
+		{"DefaultHighValue", state.DefaultHighValue, 500.0},
+		{"MinValue", state.MinValue, 0.0},
+		{"MaxValue", state.MaxValue, 1000.0},
+		{"Step", state.Step, 10.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeSlider_InvalidBounds(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	low, high := builder.RangeSlider("Price",
+		rangeslider.WithMinValue(100),
+		rangeslider.WithMaxValue(0),
+		rangeslider.WithStep(-5),
+	)
+	if low != 0 || high != 100 {
+		t.Errorf("RangeSlider() = (%v, %v), want (0, 100)", low, high)
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeRangeSlider, []int{0})
+	state := sess.State.GetRangeSlider(widgetID)
+	if state.Step != 1 {
+		t.Errorf("Step = %v, want 1", state.Step)
+	}
+}
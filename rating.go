@@ -0,0 +1,114 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/rating"
+)
+
+const defaultRatingMaxValue = 5
+
+func (b *uiBuilder) Rating(label string, maxValue int, opts ...rating.Option) int {
+	if maxValue <= 0 {
+		maxValue = defaultRatingMaxValue
+	}
+
+	ratingOpts := &options.RatingOptions{
+		Label:        label,
+		MaxValue:     maxValue,
+		DefaultValue: 0,
+		Required:     false,
+		Disabled:     false,
+	}
+
+	for _, o := range opts {
+		o.Apply(ratingOpts)
+	}
+
+	defaultValue := clampRating(ratingOpts.DefaultValue, ratingOpts.MaxValue)
+
+	sess := b.session
+	if sess == nil {
+		return defaultValue
+	}
+	page := b.page
+	if page == nil {
+		return defaultValue
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return defaultValue
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeRating, path)
+	ratingState := sess.State.GetRating(widgetID)
+	if ratingState == nil {
+		ratingState = &state.RatingState{
+			ID:    widgetID,
+			Value: defaultValue,
+		}
+	}
+	ratingState.Value = clampRating(ratingState.Value, ratingOpts.MaxValue)
+	ratingState.Label = ratingOpts.Label
+	ratingState.MaxValue = ratingOpts.MaxValue
+	ratingState.DefaultValue = defaultValue
+	ratingState.Required = ratingOpts.Required
+	ratingState.Disabled = ratingOpts.Disabled
+	sess.State.Set(widgetID, ratingState)
+
+	ratingProto := convertStateToRatingProto(ratingState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Rating{
+				Rating: ratingProto,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return ratingState.Value
+}
+
+// clampRating keeps v within [0, maxValue]; 0 means no rating has been given.
+func clampRating(v, maxValue int) int {
+	return max(0, min(v, maxValue))
+}
+
+func convertStateToRatingProto(state *state.RatingState) *widgetv1.Rating {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Rating{
+		Value:        int32(state.Value),
+		Label:        state.Label,
+		MaxValue:     int32(state.MaxValue),
+		DefaultValue: int32(state.DefaultValue),
+		Required:     state.Required,
+		Disabled:     state.Disabled,
+	}
+}
+
+func convertRatingProtoToState(id uuid.UUID, data *widgetv1.Rating) *state.RatingState {
+	if data == nil {
+		return nil
+	}
+	return &state.RatingState{
+		ID:           id,
+		Value:        int(data.Value),
+		Label:        data.Label,
+		MaxValue:     int(data.MaxValue),
+		DefaultValue: int(data.DefaultValue),
+		Required:     data.Required,
+		Disabled:     data.Disabled,
+	}
+}
@@ -0,0 +1,37 @@
+package rating
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.RatingOptions)
+}
+
+type defaultValueOption int
+
+func (d defaultValueOption) Apply(opts *options.RatingOptions) {
+	opts.DefaultValue = int(d)
+}
+
+func WithDefaultValue(defaultValue int) Option {
+	return defaultValueOption(defaultValue)
+}
+
+type requiredOption bool
+
+func (r requiredOption) Apply(opts *options.RatingOptions) {
+	opts.Required = bool(r)
+}
+
+func WithRequired(required bool) Option {
+	return requiredOption(required)
+}
+
+type disabledOption bool
+
+func (d disabledOption) Apply(opts *options.RatingOptions) {
+	opts.Disabled = bool(d)
+}
+
+func WithDisabled(disabled bool) Option {
+	return disabledOption(disabled)
+}
@@ -0,0 +1,154 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/rating"
+)
+
+func TestConvertStateToRatingProto(t *testing.T) {
+	ratingState := &state.RatingState{
+		ID:           uuid.Must(uuid.NewV4()),
+		Label:        "Test Rating",
+		Value:        3,
+		MaxValue:     5,
+		DefaultValue: 1,
+		Required:     true,
+		Disabled:     false,
+	}
+
+	data := convertStateToRatingProto(ratingState)
+
+	if data == nil {
+		t.Fatal("convertStateToRatingProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", data.Label, ratingState.Label},
+		{"Value", data.Value, int32(ratingState.Value)},
+		{"MaxValue", data.MaxValue, int32(ratingState.MaxValue)},
+		{"DefaultValue", data.DefaultValue, int32(ratingState.DefaultValue)},
+		{"Required", data.Required, ratingState.Required},
+		{"Disabled", data.Disabled, ratingState.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertRatingProtoToState(t *testing.T) {
+	data := &widgetv1.Rating{
+		Label:        "Test Rating",
+		Value:        4,
+		MaxValue:     10,
+		DefaultValue: 0,
+		Required:     false,
+		Disabled:     true,
+	}
+
+	state := convertRatingProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertRatingProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, data.Label},
+		{"Value", state.Value, int(data.Value)},
+		{"MaxValue", state.MaxValue, int(data.MaxValue)},
+		{"DefaultValue", state.DefaultValue, int(data.DefaultValue)},
+		{"Required", state.Required, data.Required},
+		{"Disabled", state.Disabled, data.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRating(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	label := "Satisfaction"
+	value := builder.Rating(label, 10, rating.WithDefaultValue(7))
+
+	if value != 7 {
+		t.Errorf("Rating value = %d, want 7", value)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeRating, []int{0})
+	state := sess.State.GetRating(widgetID)
+	if state == nil {
+		t.Fatal("Rating state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, label},
+		{"Value", state.Value, 7},
+		{"MaxValue", state.MaxValue, 10},
+		{"DefaultValue", state.DefaultValue, 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	// Lowering the maximum clamps an existing rating on the next run.
+	builder.cursor = newCursor()
+	if got := builder.Rating(label, 0); got != 5 {
+		t.Errorf("Rating value with default max = %d, want 5", got)
+	}
+}
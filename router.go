@@ -1,15 +1,26 @@
 package sourcetool
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	"github.com/trysourcetool/sourcetool-go/pageoption"
 )
 
+// PageHandler renders a page with the given UIBuilder.
+type PageHandler func(UIBuilder) error
+
+// Middleware wraps a PageHandler, running code before and after next.
+type Middleware func(next PageHandler) PageHandler
+
 type Router interface {
-	Page(relativePath, name string, handler func(UIBuilder) error)
+	Page(relativePath, name string, handler func(UIBuilder) error, opts ...pageoption.Option)
 	AccessGroups(groups ...string) Router
 	Group(relativePath string) Router
+	Use(middlewares ...Middleware) Router
 }
 
 type router struct {
@@ -18,6 +29,7 @@ type router struct {
 	basePath     string
 	namespaceDNS string
 	groups       []string
+	middlewares  []Middleware
 }
 
 func newRouter(st *Sourcetool, namespaceDNS string) Router {
@@ -29,8 +41,12 @@ func newRouter(st *Sourcetool, namespaceDNS string) Router {
 }
 
 func (r *router) generatePageID(fullPath string) uuid.UUID {
-	ns := uuid.NewV5(uuid.NamespaceDNS, r.namespaceDNS)
-	return uuid.NewV5(ns, fullPath+"-"+r.sourcetool.environment)
+	return generatePageID(r.namespaceDNS, r.sourcetool.environment, fullPath)
+}
+
+func generatePageID(namespace, environment, fullPath string) uuid.UUID {
+	ns := uuid.NewV5(uuid.NamespaceDNS, namespace)
+	return uuid.NewV5(ns, fullPath+"-"+environment)
 }
 
 func (r *router) joinPath(relativePath string) string {
@@ -75,9 +91,23 @@ func (r *router) collectGroups() []string {
 	return groups
 }
 
-func (r *router) Page(relativePath, name string, handler func(UIBuilder) error) {
+// collectMiddlewares returns the middlewares of r and its parents, outermost first.
+func (r *router) collectMiddlewares() []Middleware {
+	var chain [][]Middleware
+	for current := r; current != nil; current = current.parent {
+		chain = append(chain, current.middlewares)
+	}
+	middlewares := make([]Middleware, 0)
+	for i := len(chain) - 1; i >= 0; i-- {
+		middlewares = append(middlewares, chain[i]...)
+	}
+	return middlewares
+}
+
+func (r *router) Page(relativePath, name string, handler func(UIBuilder) error, opts ...pageoption.Option) {
 	// Skip page creation only for top-level root path
 	if relativePath == "/" && r.basePath == "" {
+		r.sourcetool.addPageErr(fmt.Errorf("page %q is registered at the root path \"/\", which is not supported", name))
 		return
 	}
 
@@ -91,15 +121,41 @@ func (r *router) Page(relativePath, name string, handler func(UIBuilder) error)
 	} else {
 		fullPath = r.joinPath(relativePath)
 	}
+	pageOpts := &options.PageOptions{
+		ID:          "",
+		Icon:        "",
+		Description: "",
+		Order:       nil,
+		Hidden:      false,
+		GroupLabel:  "",
+	}
+
+	for _, o := range opts {
+		o.Apply(pageOpts)
+	}
+
 	pageID := r.generatePageID(fullPath)
+	if pageOpts.ID != "" {
+		id, err := uuid.FromString(pageOpts.ID)
+		if err != nil {
+			r.sourcetool.addPageErr(fmt.Errorf("page %q has an invalid ID %q: %v", name, pageOpts.ID, err))
+			return
+		}
+		pageID = id
+	}
 
 	page := &page{
 		id:           pageID,
 		name:         name,
 		route:        fullPath,
-		path:         []int{len(r.sourcetool.pages)},
 		handler:      handler,
 		accessGroups: removeDuplicates(r.collectGroups()),
+		middlewares:  r.collectMiddlewares(),
+		icon:         pageOpts.Icon,
+		description:  pageOpts.Description,
+		order:        pageOpts.Order,
+		hidden:       pageOpts.Hidden,
+		groupLabel:   pageOpts.GroupLabel,
 	}
 
 	r.sourcetool.addPage(pageID, page)
@@ -112,6 +168,16 @@ func (r *router) AccessGroups(groups ...string) Router {
 	return r
 }
 
+// Use adds middlewares that run around the handlers of pages registered on
+// this router and its groups after the call. Middlewares run in the order they
+// are added, parent router middlewares first.
+func (r *router) Use(middlewares ...Middleware) Router {
+	if len(middlewares) > 0 {
+		r.middlewares = append(r.middlewares, middlewares...)
+	}
+	return r
+}
+
 func (r *router) Group(relativePath string) Router {
 	newRouter := &router{
 		parent:       r,
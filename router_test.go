@@ -2,7 +2,10 @@ package sourcetool
 
 import (
 	"errors"
+	"reflect"
 	"testing"
+
+	"github.com/trysourcetool/sourcetool-go/pageoption"
 )
 
 func TestJoinPath(t *testing.T) {
@@ -180,7 +183,7 @@ func TestRouterAccessGroups(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 
 		st.AccessGroups("global")
 		admin := st.Group("/admin")
@@ -211,7 +214,7 @@ func TestRouterAccessGroups(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		admin := st.Group("/admin")
 
 		admin.AccessGroups("admin")
@@ -245,7 +248,7 @@ func TestRouterAccessGroups(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		st.AccessGroups("global")
 
 		users := st.Group("/users")
@@ -277,7 +280,7 @@ func TestRouterAccessGroups(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		st.AccessGroups("global")
 
 		api := st.Group("/api")
@@ -303,7 +306,7 @@ func TestRouterAccessGroups(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 
 		admin := st.Group("/admin")
 		admin.AccessGroups("admin")
@@ -371,7 +374,7 @@ func TestRouterGroup(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		admin := st.Group("/admin")
 		settings := admin.Group("/settings")
 		settings.Page("/users", "User Settings", pageHandler)
@@ -391,7 +394,7 @@ func TestRouterGroup(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		api := st.Group("/api")
 		v1 := api.Group("/v1")
 		users := v1.Group("/users")
@@ -414,7 +417,7 @@ func TestRouter_Page(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		handler := func(ui UIBuilder) error { return nil }
 		st.Page("/test", "Test Page", handler)
 
@@ -433,7 +436,7 @@ func TestRouter_Page(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		handler := func(ui UIBuilder) error { return nil }
 		st.Page("/", "Root Page", handler)
 
@@ -455,7 +458,7 @@ func TestRouter_Page(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		handler := func(ui UIBuilder) error { return nil }
 
 		users := st.Group("/users")
@@ -488,7 +491,7 @@ func TestRouter_Page(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		st.AccessGroups("admin")
 		handler := func(ui UIBuilder) error { return nil }
 		st.Page("/admin", "Admin Page", handler)
@@ -508,7 +511,7 @@ func TestRouter_Page(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		handler := func(ui UIBuilder) error {
 			return errors.New("test error")
 		}
@@ -529,7 +532,7 @@ func TestRouter_Page(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		handler := func(ui UIBuilder) error { return nil }
 		st.Page("", "Root Page", handler)
 
@@ -545,6 +548,10 @@ func TestRouter_Page(t *testing.T) {
 		if page.name != "Root Page" {
 			t.Errorf("Expected page name 'Root Page', got %q", page.name)
 		}
+
+		if err := st.validatePages(); err != nil {
+			t.Errorf("validatePages() = %v, want nil", err)
+		}
 	})
 
 	t.Run("Page with duplicate route", func(t *testing.T) {
@@ -552,7 +559,7 @@ func TestRouter_Page(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		handler := func(ui UIBuilder) error { return nil }
 		st.Page("/duplicate", "First Page", handler)
 		st.Page("/duplicate", "Second Page", handler)
@@ -574,7 +581,7 @@ func TestRouter_Group(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		group := st.Group("/test")
 
 		if group == nil {
@@ -595,7 +602,7 @@ func TestRouter_Group(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		group := st.Group("/admin")
 		group.AccessGroups("admin")
 
@@ -617,7 +624,7 @@ func TestRouter_Group(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		parent := st.Group("/parent")
 		child := parent.Group("/child")
 
@@ -639,7 +646,7 @@ func TestRouter_Group(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		group := st.Group("")
 
 		if group == nil {
@@ -662,7 +669,7 @@ func TestRouter_AccessGroups(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		st.AccessGroups("admin", "user")
 
 		handler := func(ui UIBuilder) error { return nil }
@@ -697,7 +704,7 @@ func TestRouter_AccessGroups(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		st.AccessGroups("admin")
 		st.AccessGroups()
 
@@ -714,3 +721,175 @@ func TestRouter_AccessGroups(t *testing.T) {
 		}
 	})
 }
+
+func TestPage_MatchPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		route   string
+		urlPath string
+		want    map[string]string
+		wantOK  bool
+	}{
+		{"Static", "/users", "/users", map[string]string{}, true},
+		{"Static mismatch", "/users", "/orders", nil, false},
+		{"Param", "/customers/:id", "/customers/42", map[string]string{"id": "42"}, true},
+		{"Param escaped", "/customers/:id", "/customers/a%2Fb", map[string]string{"id": "a/b"}, true},
+		{"Param missing", "/customers/:id", "/customers", nil, false},
+		{"Param extra segment", "/customers/:id", "/customers/42/edit", nil, false},
+		{"Multiple params", "/orgs/:org/users/:user", "/orgs/acme/users/7", map[string]string{"org": "acme", "user": "7"}, true},
+		{"Wildcard", "/files/*rest", "/files/a/b/c.txt", map[string]string{"rest": "a/b/c.txt"}, true},
+		{"Wildcard empty", "/files/*rest", "/files", map[string]string{"rest": ""}, true},
+		{"Trailing slash", "/customers/:id", "/customers/42/", map[string]string{"id": "42"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &page{route: tt.route}
+			got, ok := p.matchPath(tt.urlPath)
+			if ok != tt.wantOK {
+				t.Fatalf("matchPath(%q) ok = %v, want %v", tt.urlPath, ok, tt.wantOK)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("matchPath(%q) = %v, want %v", tt.urlPath, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRouteParams(t *testing.T) {
+	tests := []struct {
+		route string
+		want  []string
+	}{
+		{"/users", nil},
+		{"/customers/:id", []string{"id"}},
+		{"/orgs/:org/files/*rest", []string{"org", "rest"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.route, func(t *testing.T) {
+			if got := routeParams(tt.route); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("routeParams(%q) = %v, want %v", tt.route, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRouter_Use(t *testing.T) {
+	var calls []string
+	record := func(name string) Middleware {
+		return func(next PageHandler) PageHandler {
+			return func(ui UIBuilder) error {
+				calls = append(calls, name+":before")
+				err := next(ui)
+				calls = append(calls, name+":after")
+				return err
+			}
+		}
+	}
+
+	config := &Config{
+		APIKey:   "test_apikey",
+		Endpoint: "ws://test.trysourcetool.com",
+	}
+	st := newTestSourcetool(t, config)
+	st.Use(record("root"))
+	admin := st.Group("/admin")
+	admin.Use(record("admin"))
+	admin.Page("/users", "Users", func(ui UIBuilder) error {
+		calls = append(calls, "handler")
+		return nil
+	})
+	st.Page("/home", "Home", func(ui UIBuilder) error {
+		calls = append(calls, "handler")
+		return nil
+	})
+
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"/admin/users", []string{"root:before", "admin:before", "handler", "admin:after", "root:after"}},
+		{"/home", []string{"root:before", "handler", "root:after"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			calls = nil
+			page := findPageByPath(st.pages, tt.path)
+			if page == nil {
+				t.Fatal("Page not found")
+			}
+			if err := page.run(nil); err != nil {
+				t.Fatalf("run() returned error: %v", err)
+			}
+			if !reflect.DeepEqual(calls, tt.want) {
+				t.Errorf("calls = %v, want %v", calls, tt.want)
+			}
+		})
+	}
+
+	t.Run("Short circuit", func(t *testing.T) {
+		wantErr := errors.New("forbidden")
+		st := newTestSourcetool(t, config)
+		st.Use(func(next PageHandler) PageHandler {
+			return func(ui UIBuilder) error {
+				return wantErr
+			}
+		})
+		called := false
+		st.Page("/secret", "Secret", func(ui UIBuilder) error {
+			called = true
+			return nil
+		})
+
+		page := findPageByPath(st.pages, "/secret")
+		if err := page.run(nil); !errors.Is(err, wantErr) {
+			t.Errorf("run() error = %v, want %v", err, wantErr)
+		}
+		if called {
+			t.Error("page handler was called")
+		}
+	})
+}
+
+func TestRouter_PageOptions(t *testing.T) {
+	config := &Config{
+		APIKey:   "test_apikey",
+		Endpoint: "ws://test.trysourcetool.com",
+	}
+	st := newTestSourcetool(t, config)
+	handler := func(ui UIBuilder) error { return nil }
+	st.Page("/users", "Users", handler,
+		pageoption.WithIcon("users"),
+		pageoption.WithDescription("Manage users"),
+		pageoption.WithOrder(2),
+		pageoption.WithGroupLabel("Admin"),
+	)
+	st.Page("/users/:id", "User", handler, pageoption.WithHidden(true))
+
+	users := convertPageToProto(findPageByPath(st.pages, "/users"))
+	user := convertPageToProto(findPageByPath(st.pages, "/users/:id"))
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Icon", users.Icon, "users"},
+		{"Description", users.Description, "Manage users"},
+		{"Order", users.GetOrder(), int32(2)},
+		{"GroupLabel", users.GroupLabel, "Admin"},
+		{"Hidden", users.Hidden, false},
+		{"Hidden page", user.Hidden, true},
+		{"Hidden page order set", user.Order != nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"net/url"
+	"sync"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
@@ -11,6 +14,7 @@ import (
 
 	"github.com/trysourcetool/sourcetool-go/internal/errdefs"
 	"github.com/trysourcetool/sourcetool-go/internal/logger"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
 	exceptionv1 "github.com/trysourcetool/sourcetool-go/internal/pb/exception/v1"
 	pagev1 "github.com/trysourcetool/sourcetool-go/internal/pb/page/v1"
 	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
@@ -20,30 +24,54 @@ import (
 	"github.com/trysourcetool/sourcetool-go/internal/websocket"
 )
 
+// searchTimeout bounds how long a search callback may run before its
+// context is cancelled.
+const searchTimeout = 10 * time.Second
+
 type runtime struct {
 	wsClient       websocket.Client
 	sessionManager *session.SessionManager
 	pageManager    *pageManager
+	apiKey         string
+	location       *time.Location
+	// manifestMu serializes manifest sends so the last one sent is the latest.
+	manifestMu sync.Mutex
+	// manifestPushes tracks the manifest updates sent by pushManifest.
+	manifestPushes sync.WaitGroup
+	// searches tracks the search callbacks run by handleSearchOptions, and
+	// searchCtx is cancelled by Close to stop them.
+	searches       sync.WaitGroup
+	searchCtx      context.Context
+	cancelSearches context.CancelFunc
+	// closeMu guards closing and searchCtx. closing stops new background
+	// sends once Close has started waiting for the running ones.
+	closeMu sync.Mutex
+	closing bool
 }
 
-func startRuntime(apiKey, endpoint string, pages map[uuid.UUID]*page) (*runtime, error) {
+func startRuntime(apiKey, endpoint string, pages map[uuid.UUID]*page, opts *options.SourcetoolOptions) (*runtime, error) {
 	r := &runtime{
 		sessionManager: session.NewSessionManager(),
 		pageManager:    newPageManager(pages),
+		apiKey:         apiKey,
+		location:       opts.Location,
 	}
 
 	wsClient, err := websocket.NewClient(websocket.Config{
 		URL:            endpoint,
 		APIKey:         apiKey,
 		InstanceID:     uuid.Must(uuid.NewV4()),
-		PingInterval:   1 * time.Second,
-		ReconnectDelay: 1 * time.Second,
+		PingInterval:   opts.PingInterval,
+		ReconnectDelay: opts.ReconnectDelay,
+		QueueSize:      opts.QueueSize,
 		OnReconnecting: func() {
 			logger.Log.Info("Reconnecting...")
 		},
 		OnReconnected: func() {
 			logger.Log.Info("Reconnected!")
-			r.sendInitializeHost(apiKey, pages)
+			if err := r.sendInitializeHost(); err != nil {
+				logger.Log.Fatal("failed to initialize host", zap.Error(err))
+			}
 		},
 	})
 	if err != nil {
@@ -68,30 +96,67 @@ func startRuntime(apiKey, endpoint string, pages map[uuid.UUID]*page) (*runtime,
 				r.sendException(msg.Id, t.CloseSession.SessionId, err)
 			}
 			return nil
+		case *websocketv1.Message_FileUploadStart:
+			if err := r.handleFileUploadStart(t.FileUploadStart); err != nil {
+				r.sendException(msg.Id, t.FileUploadStart.SessionId, err)
+			}
+			return nil
+		case *websocketv1.Message_FileUploadChunk:
+			if err := r.handleFileUploadChunk(t.FileUploadChunk); err != nil {
+				r.sendException(msg.Id, t.FileUploadChunk.SessionId, err)
+			}
+			return nil
+		case *websocketv1.Message_SearchOptions:
+			if err := r.handleSearchOptions(msg.Id, t.SearchOptions); err != nil {
+				r.sendException(msg.Id, t.SearchOptions.SessionId, err)
+			}
+			return nil
 		default:
 			return fmt.Errorf("unknown message type: %T", t)
 		}
 	})
 
-	r.sendInitializeHost(apiKey, pages)
+	if err := r.sendInitializeHost(); err != nil {
+		wsClient.Close()
+		return nil, fmt.Errorf("failed to initialize host: %v", err)
+	}
 
 	return r, nil
 }
 
-func (r *runtime) sendInitializeHost(apiKey string, pages map[uuid.UUID]*page) {
+// pushManifest sends the current page manifest in the background. Pages can
+// be added or removed from a page handler, which runs on the goroutine that
+// reads the host's reply, so waiting for it here would deadlock. Nothing is
+// sent once the runtime is closing.
+func (r *runtime) pushManifest() {
+	r.closeMu.Lock()
+	defer r.closeMu.Unlock()
+	if r.closing {
+		return
+	}
+	r.manifestPushes.Add(1)
+	go func() {
+		defer r.manifestPushes.Done()
+		if err := r.sendInitializeHost(); err != nil {
+			logger.Log.Error("failed to send page manifest", zap.Error(err))
+		}
+	}()
+}
+
+// sendInitializeHost sends the current page manifest. It runs at startup, on
+// reconnect and whenever pages are added or removed after Listen.
+func (r *runtime) sendInitializeHost() error {
+	r.manifestMu.Lock()
+	defer r.manifestMu.Unlock()
+
+	pages := r.pageManager.getPages()
 	pagesPayload := make([]*pagev1.Page, 0, len(pages))
 	for _, page := range pages {
-		pagesPayload = append(pagesPayload, &pagev1.Page{
-			Id:     page.id.String(),
-			Name:   page.name,
-			Route:  page.route,
-			Path:   convertPathToInt32Slice(page.path),
-			Groups: page.accessGroups,
-		})
+		pagesPayload = append(pagesPayload, convertPageToProto(page))
 	}
 
 	msg := &websocketv1.InitializeHost{
-		ApiKey:     apiKey,
+		ApiKey:     r.apiKey,
 		SdkName:    "sourcetool-go",
 		SdkVersion: "0.1.12",
 		Pages:      pagesPayload,
@@ -99,14 +164,16 @@ func (r *runtime) sendInitializeHost(apiKey string, pages map[uuid.UUID]*page) {
 
 	resp, err := r.wsClient.EnqueueWithResponse(uuid.Must(uuid.NewV4()).String(), msg)
 	if err != nil {
-		logger.Log.Fatal("failed to send initialize host message", zap.Error(err))
+		return fmt.Errorf("failed to send initialize host message: %v", err)
 	}
 
 	if e := resp.GetException(); e != nil {
-		logger.Log.Fatal("initialize host message failed", zap.String("message", e.Message))
+		return fmt.Errorf("initialize host message failed: %s", e.Message)
 	}
 
 	logger.Log.Info("initialize host message sent", zap.Any("response", resp))
+
+	return nil
 }
 
 func (r *runtime) handleInitializeClient(msg *websocketv1.InitializeClient) error {
@@ -130,15 +197,32 @@ func (r *runtime) handleInitializeClient(msg *websocketv1.InitializeClient) erro
 		return errdefs.ErrInternal(fmt.Errorf("page not found: %s", pageID))
 	}
 
+	params, err := matchPageParams(page, msg.UrlPath)
+	if err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+	session.Params = params
+
+	queryParams, err := url.ParseQuery(msg.QueryString)
+	if err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+	session.QueryParams = queryParams
+	session.UserGroups = msg.UserGroups
+
+	ctx, cancel := context.WithCancel(context.Background())
 	ui := &uiBuilder{
-		context: context.Background(),
+		context: ctx,
 		runtime: r,
 		session: session,
 		page:    page,
 		cursor:  newCursor(),
 	}
 
-	if err := page.run(ui); err != nil {
+	err = page.run(ui)
+	cancel()
+	if err != nil {
+		session.PendingNavigation = nil
 		r.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.ScriptFinished{
 			SessionId: sessionID.String(),
 			Status:    websocketv1.ScriptFinished_STATUS_FAILURE,
@@ -152,6 +236,8 @@ func (r *runtime) handleInitializeClient(msg *websocketv1.InitializeClient) erro
 		Status:    websocketv1.ScriptFinished_STATUS_SUCCESS,
 	})
 
+	r.applyNavigation(session)
+
 	return nil
 }
 
@@ -174,12 +260,40 @@ func (r *runtime) handleRerunPage(msg *websocketv1.RerunPage) error {
 		return errdefs.ErrPageNotFound(fmt.Errorf("page not found: %s", pageID))
 	}
 
-	if sess.PageID != pageID {
+	params, err := matchPageParams(page, msg.UrlPath)
+	if err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+	queryParams, err := url.ParseQuery(msg.QueryString)
+	if err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+
+	// Pages such as "/customers/:id" share one page ID across parameters, so
+	// moving from one customer to another must not carry widget state over.
+	// When only the parameters changed, the states sent by the client belong
+	// to the previous parameters and are dropped along with the rest.
+	states := msg.States
+	pageChanged := sess.PageID != pageID
+	if pageChanged || !maps.Equal(sess.Params, params) {
 		sess.State.ResetStates()
+		sess.Uploads.Reset()
+		sess.Searches.Reset()
+		sess.PageID = pageID
+		if !pageChanged {
+			states = nil
+		}
+	}
+	sess.Params = params
+	sess.QueryParams = queryParams
+
+	location := r.location
+	if location == nil {
+		location = time.Local
 	}
 
 	newWidgetStates := make(map[uuid.UUID]session.WidgetState)
-	for _, widget := range msg.States {
+	for _, widget := range states {
 		id, err := uuid.FromString(widget.Id)
 		if err != nil {
 			return errdefs.ErrInvalidParameter(err)
@@ -190,19 +304,19 @@ func (r *runtime) handleRerunPage(msg *websocketv1.RerunPage) error {
 		case *widgetv1.Widget_NumberInput:
 			newWidgetStates[id] = convertNumberInputProtoToState(id, t.NumberInput)
 		case *widgetv1.Widget_DateInput:
-			state, err := convertDateInputProtoToState(id, t.DateInput, time.Local)
+			state, err := convertDateInputProtoToState(id, t.DateInput, location)
 			if err != nil {
 				return errdefs.ErrInvalidParameter(err)
 			}
 			newWidgetStates[id] = state
 		case *widgetv1.Widget_DateTimeInput:
-			state, err := convertDateTimeInputProtoToState(id, t.DateTimeInput, time.Local)
+			state, err := convertDateTimeInputProtoToState(id, t.DateTimeInput, location)
 			if err != nil {
 				return errdefs.ErrInvalidParameter(err)
 			}
 			newWidgetStates[id] = state
 		case *widgetv1.Widget_TimeInput:
-			state, err := convertTimeInputProtoToState(id, t.TimeInput, time.Local)
+			state, err := convertTimeInputProtoToState(id, t.TimeInput, location)
 			if err != nil {
 				return errdefs.ErrInvalidParameter(err)
 			}
@@ -231,6 +345,60 @@ func (r *runtime) handleRerunPage(msg *websocketv1.RerunPage) error {
 			newWidgetStates[id] = convertRadioProtoToState(id, t.Radio)
 		case *widgetv1.Widget_TextArea:
 			newWidgetStates[id] = convertTextAreaProtoToState(id, t.TextArea)
+		case *widgetv1.Widget_Alert:
+			newWidgetStates[id] = convertAlertProtoToState(id, t.Alert)
+		case *widgetv1.Widget_Metric:
+			newWidgetStates[id] = convertMetricProtoToState(id, t.Metric)
+		case *widgetv1.Widget_Progress:
+			newWidgetStates[id] = convertProgressProtoToState(id, t.Progress)
+		case *widgetv1.Widget_Spinner:
+			newWidgetStates[id] = convertSpinnerProtoToState(id, t.Spinner)
+		case *widgetv1.Widget_FileUploader:
+			state, err := convertFileUploaderProtoToState(id, t.FileUploader)
+			if err != nil {
+				return errdefs.ErrInvalidParameter(err)
+			}
+			newWidgetStates[id] = state
+		case *widgetv1.Widget_DownloadButton:
+			newWidgetStates[id] = convertDownloadButtonProtoToState(id, t.DownloadButton)
+		case *widgetv1.Widget_Image:
+			newWidgetStates[id] = convertImageProtoToState(id, t.Image)
+		case *widgetv1.Widget_Video:
+			newWidgetStates[id] = convertVideoProtoToState(id, t.Video)
+		case *widgetv1.Widget_Audio:
+			newWidgetStates[id] = convertAudioProtoToState(id, t.Audio)
+		case *widgetv1.Widget_Code:
+			newWidgetStates[id] = convertCodeProtoToState(id, t.Code)
+		case *widgetv1.Widget_Json:
+			newWidgetStates[id] = convertJSONProtoToState(id, t.Json)
+		case *widgetv1.Widget_Slider:
+			newWidgetStates[id] = convertSliderProtoToState(id, t.Slider)
+		case *widgetv1.Widget_RangeSlider:
+			newWidgetStates[id] = convertRangeSliderProtoToState(id, t.RangeSlider)
+		case *widgetv1.Widget_DateRangeSlider:
+			state, err := convertDateRangeSliderProtoToState(id, t.DateRangeSlider, location)
+			if err != nil {
+				return errdefs.ErrInvalidParameter(err)
+			}
+			newWidgetStates[id] = state
+		case *widgetv1.Widget_DateRangeInput:
+			state, err := convertDateRangeInputProtoToState(id, t.DateRangeInput, location)
+			if err != nil {
+				return errdefs.ErrInvalidParameter(err)
+			}
+			newWidgetStates[id] = state
+		case *widgetv1.Widget_Toggle:
+			newWidgetStates[id] = convertToggleProtoToState(id, t.Toggle)
+		case *widgetv1.Widget_ColorPicker:
+			state, err := convertColorPickerProtoToState(id, t.ColorPicker)
+			if err != nil {
+				return errdefs.ErrInvalidParameter(err)
+			}
+			newWidgetStates[id] = state
+		case *widgetv1.Widget_Rating:
+			newWidgetStates[id] = convertRatingProtoToState(id, t.Rating)
+		case *widgetv1.Widget_IntInput:
+			newWidgetStates[id] = convertIntInputProtoToState(id, t.IntInput)
 		default:
 			return errdefs.ErrInvalidParameter(fmt.Errorf("unknown widget type: %T", t))
 		}
@@ -238,15 +406,19 @@ func (r *runtime) handleRerunPage(msg *websocketv1.RerunPage) error {
 
 	sess.State.SetStates(newWidgetStates)
 
+	ctx, cancel := context.WithCancel(context.Background())
 	ui := &uiBuilder{
-		context: context.Background(),
+		context: ctx,
 		runtime: r,
 		session: sess,
 		page:    page,
 		cursor:  newCursor(),
 	}
 
-	if err := page.run(ui); err != nil {
+	err = page.run(ui)
+	cancel()
+	if err != nil {
+		sess.PendingNavigation = nil
 		r.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.ScriptFinished{
 			SessionId: sessionID.String(),
 			Status:    websocketv1.ScriptFinished_STATUS_FAILURE,
@@ -261,6 +433,7 @@ func (r *runtime) handleRerunPage(msg *websocketv1.RerunPage) error {
 	})
 
 	sess.State.ResetButtons()
+	r.applyNavigation(sess)
 
 	return nil
 }
@@ -276,6 +449,111 @@ func (r *runtime) handleCloseSession(msg *websocketv1.CloseSession) error {
 	return nil
 }
 
+func (r *runtime) handleFileUploadStart(msg *websocketv1.FileUploadStart) error {
+	sessionID, err := uuid.FromString(msg.SessionId)
+	if err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+	sess := r.sessionManager.GetSession(sessionID)
+	if sess == nil {
+		return errdefs.ErrSessionNotFound(fmt.Errorf("session not found: %s", sessionID))
+	}
+
+	if err := startFileUpload(sess, msg); err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+
+	return nil
+}
+
+func (r *runtime) handleFileUploadChunk(msg *websocketv1.FileUploadChunk) error {
+	sessionID, err := uuid.FromString(msg.SessionId)
+	if err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+	sess := r.sessionManager.GetSession(sessionID)
+	if sess == nil {
+		return errdefs.ErrSessionNotFound(fmt.Errorf("session not found: %s", sessionID))
+	}
+	fileID, err := uuid.FromString(msg.FileId)
+	if err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+
+	if err := sess.Uploads.Append(fileID, msg.Offset, msg.Data, msg.Last); err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+
+	return nil
+}
+
+func (r *runtime) handleSearchOptions(id string, msg *websocketv1.SearchOptions) error {
+	sessionID, err := uuid.FromString(msg.SessionId)
+	if err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+	sess := r.sessionManager.GetSession(sessionID)
+	if sess == nil {
+		return errdefs.ErrSessionNotFound(fmt.Errorf("session not found: %s", sessionID))
+	}
+	widgetID, err := uuid.FromString(msg.WidgetId)
+	if err != nil {
+		return errdefs.ErrInvalidParameter(err)
+	}
+
+	// Search callbacks often call external services, so they run off the
+	// read loop and the result is sent whenever it is ready.
+	r.closeMu.Lock()
+	defer r.closeMu.Unlock()
+	if r.closing {
+		return nil
+	}
+	if r.searchCtx == nil {
+		r.searchCtx, r.cancelSearches = context.WithCancel(context.Background())
+	}
+	searchCtx := r.searchCtx
+	r.searches.Add(1)
+	go func() {
+		defer r.searches.Done()
+		ctx, cancel := context.WithTimeout(searchCtx, searchTimeout)
+		defer cancel()
+
+		result := &websocketv1.SearchOptionsResult{
+			SessionId: msg.SessionId,
+			WidgetId:  msg.WidgetId,
+			Query:     msg.Query,
+		}
+		values, labels, err := sess.Searches.Search(ctx, widgetID, msg.Query)
+		if errors.Is(err, context.Canceled) {
+			// A newer query of the same widget replaced this one, or the
+			// runtime is closing.
+			return
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		result.Values = values
+		result.Labels = labels
+
+		r.wsClient.Enqueue(id, result)
+	}()
+
+	return nil
+}
+
+// matchPageParams extracts the route parameters of urlPath. An empty urlPath
+// yields no parameters so clients that do not send it keep working.
+func matchPageParams(page *page, urlPath string) (map[string]string, error) {
+	if urlPath == "" {
+		return map[string]string{}, nil
+	}
+	params, ok := page.matchPath(urlPath)
+	if !ok {
+		return nil, fmt.Errorf("path %q does not match route %q", urlPath, page.route)
+	}
+	return params, nil
+}
+
 func (r *runtime) sendException(id, sessionID string, err error) {
 	e, ok := err.(*errdefs.Error)
 	if !ok {
@@ -293,7 +571,23 @@ func (r *runtime) sendException(id, sessionID string, err error) {
 	r.wsClient.Enqueue(id, exception)
 }
 
+// Close cancels the running searches, waits for them and the manifest
+// updates in flight, and closes the connection. Closing an already closed
+// runtime does nothing.
 func (r *runtime) Close() error {
+	r.closeMu.Lock()
+	if r.closing {
+		r.closeMu.Unlock()
+		return nil
+	}
+	r.closing = true
+	if r.cancelSearches != nil {
+		r.cancelSearches()
+	}
+	r.closeMu.Unlock()
+
+	r.searches.Wait()
+	r.manifestPushes.Wait()
 	err := r.wsClient.Close()
 	r.wsClient = nil
 	return err
@@ -6,8 +6,10 @@ import (
 	"github.com/gofrs/uuid/v5"
 
 	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
 	"github.com/trysourcetool/sourcetool-go/internal/ptrconv"
 	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
 	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
 )
 
@@ -162,3 +164,226 @@ func TestRuntime_HandleCloseSession(t *testing.T) {
 		t.Error("session was not deleted")
 	}
 }
+
+func TestRuntime_RouteParams(t *testing.T) {
+	pages := make(map[uuid.UUID]*page)
+	pageID := uuid.Must(uuid.NewV4())
+	sessionID := uuid.Must(uuid.NewV4())
+
+	var got map[string]string
+	pages[pageID] = &page{
+		id:    pageID,
+		name:  "Customer",
+		route: "/customers/:id",
+		handler: func(ui UIBuilder) error {
+			got = ui.Params()
+			return nil
+		},
+	}
+
+	mockClient := mock.NewClient()
+	r := &runtime{
+		wsClient:       mockClient,
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(pages),
+	}
+
+	if err := r.handleInitializeClient(&websocketv1.InitializeClient{
+		SessionId: ptrconv.StringPtr(sessionID.String()),
+		PageId:    pageID.String(),
+		UrlPath:   "/customers/42",
+	}); err != nil {
+		t.Fatalf("handleInitializeClient returned error: %v", err)
+	}
+	if got["id"] != "42" {
+		t.Errorf("Params()[id] = %q, want %q", got["id"], "42")
+	}
+
+	if err := r.handleRerunPage(&websocketv1.RerunPage{
+		SessionId: sessionID.String(),
+		PageId:    pageID.String(),
+		UrlPath:   "/customers/43",
+	}); err != nil {
+		t.Fatalf("handleRerunPage returned error: %v", err)
+	}
+	if got["id"] != "43" {
+		t.Errorf("Params()[id] after rerun = %q, want %q", got["id"], "43")
+	}
+
+	if err := r.handleRerunPage(&websocketv1.RerunPage{
+		SessionId: sessionID.String(),
+		PageId:    pageID.String(),
+		UrlPath:   "/orders/43",
+	}); err == nil {
+		t.Error("handleRerunPage with mismatched path returned nil error")
+	}
+}
+
+func TestRuntime_RouteParamsResetState(t *testing.T) {
+	pages := make(map[uuid.UUID]*page)
+	pageID := uuid.Must(uuid.NewV4())
+	sessionID := uuid.Must(uuid.NewV4())
+
+	var got string
+	pages[pageID] = &page{
+		id:    pageID,
+		name:  "Customer",
+		route: "/customers/:id",
+		handler: func(ui UIBuilder) error {
+			got = ui.TextInput("Note")
+			return nil
+		},
+	}
+
+	r := &runtime{
+		wsClient:       mock.NewClient(),
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(pages),
+	}
+
+	if err := r.handleInitializeClient(&websocketv1.InitializeClient{
+		SessionId: ptrconv.StringPtr(sessionID.String()),
+		PageId:    pageID.String(),
+		UrlPath:   "/customers/1",
+	}); err != nil {
+		t.Fatalf("handleInitializeClient returned error: %v", err)
+	}
+
+	ui := &uiBuilder{page: pages[pageID]}
+	widgetID := ui.generatePageID(state.WidgetTypeTextInput, []int{0})
+	note := "VIP"
+	rerun := func(urlPath string) {
+		t.Helper()
+		if err := r.handleRerunPage(&websocketv1.RerunPage{
+			SessionId: sessionID.String(),
+			PageId:    pageID.String(),
+			UrlPath:   urlPath,
+			States: []*widgetv1.Widget{
+				{
+					Id: widgetID.String(),
+					Type: &widgetv1.Widget_TextInput{
+						TextInput: &widgetv1.TextInput{Label: "Note", Value: &note},
+					},
+				},
+			},
+		}); err != nil {
+			t.Fatalf("handleRerunPage returned error: %v", err)
+		}
+	}
+
+	rerun("/customers/1")
+	if got != note {
+		t.Errorf("TextInput value = %q, want %q", got, note)
+	}
+
+	rerun("/customers/2")
+	if got != "" {
+		t.Errorf("TextInput value after changing params = %q, want empty", got)
+	}
+	if v := r.sessionManager.GetSession(sessionID).State.GetTextInput(widgetID).Value; v != nil && *v != "" {
+		t.Errorf("TextInput state after changing params = %q, want empty", *v)
+	}
+}
+
+func TestRuntime_RerunTracksPageID(t *testing.T) {
+	firstID := uuid.Must(uuid.NewV4())
+	secondID := uuid.Must(uuid.NewV4())
+	sessionID := uuid.Must(uuid.NewV4())
+
+	var got string
+	pages := map[uuid.UUID]*page{
+		firstID: {id: firstID, name: "First", route: "/first", handler: func(UIBuilder) error { return nil }},
+		secondID: {
+			id:    secondID,
+			name:  "Second",
+			route: "/second",
+			handler: func(ui UIBuilder) error {
+				got = ui.TextInput("Note")
+				return nil
+			},
+		},
+	}
+
+	r := &runtime{
+		wsClient:       mock.NewClient(),
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(pages),
+	}
+	sess := session.New(sessionID, firstID)
+	r.sessionManager.SetSession(sess)
+
+	ui := &uiBuilder{page: pages[secondID]}
+	widgetID := ui.generatePageID(state.WidgetTypeTextInput, []int{0})
+	note := "kept"
+	// The first rerun moves to another page, where the client states still
+	// apply; the second stays on it.
+	for i := range 2 {
+		got = ""
+		if err := r.handleRerunPage(&websocketv1.RerunPage{
+			SessionId: sessionID.String(),
+			PageId:    secondID.String(),
+			States: []*widgetv1.Widget{
+				{
+					Id: widgetID.String(),
+					Type: &widgetv1.Widget_TextInput{
+						TextInput: &widgetv1.TextInput{Label: "Note", Value: &note},
+					},
+				},
+			},
+		}); err != nil {
+			t.Fatalf("handleRerunPage returned error: %v", err)
+		}
+		if got != note {
+			t.Errorf("TextInput value on rerun %d = %q, want %q", i+1, got, note)
+		}
+	}
+
+	if sess.PageID != secondID {
+		t.Errorf("session page ID = %s, want %s", sess.PageID, secondID)
+	}
+}
+
+func TestRuntime_QueryParams(t *testing.T) {
+	pages := make(map[uuid.UUID]*page)
+	pageID := uuid.Must(uuid.NewV4())
+	sessionID := uuid.Must(uuid.NewV4())
+
+	var got string
+	pages[pageID] = &page{
+		id:   pageID,
+		name: "Customers",
+		handler: func(ui UIBuilder) error {
+			got = ui.QueryParams().Get("q")
+			return nil
+		},
+	}
+
+	mockClient := mock.NewClient()
+	r := &runtime{
+		wsClient:       mockClient,
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(pages),
+	}
+
+	if err := r.handleInitializeClient(&websocketv1.InitializeClient{
+		SessionId:   ptrconv.StringPtr(sessionID.String()),
+		PageId:      pageID.String(),
+		QueryString: "q=foo",
+	}); err != nil {
+		t.Fatalf("handleInitializeClient returned error: %v", err)
+	}
+	if got != "foo" {
+		t.Errorf("QueryParams().Get(q) = %q, want %q", got, "foo")
+	}
+
+	if err := r.handleRerunPage(&websocketv1.RerunPage{
+		SessionId:   sessionID.String(),
+		PageId:      pageID.String(),
+		QueryString: "q=bar",
+	}); err != nil {
+		t.Fatalf("handleRerunPage returned error: %v", err)
+	}
+	if got != "bar" {
+		t.Errorf("QueryParams().Get(q) after rerun = %q, want %q", got, "bar")
+	}
+}
@@ -1,6 +1,8 @@
 package sourcetool
 
 import (
+	"slices"
+
 	"github.com/gofrs/uuid/v5"
 
 	"github.com/trysourcetool/sourcetool-go/internal/options"
@@ -19,6 +21,7 @@ func (b *uiBuilder) Selectbox(label string, opts ...selectbox.Option) *selectbox
 		Required:     false,
 		Disabled:     false,
 		FormatFunc:   nil,
+		SearchFunc:   nil,
 	}
 
 	for _, o := range opts {
@@ -58,6 +61,14 @@ func (b *uiBuilder) Selectbox(label string, opts ...selectbox.Option) *selectbox
 			Value:        defaultVal,
 			DefaultValue: defaultVal,
 		}
+		if selectboxOpts.SearchFunc != nil {
+			selectboxState.SearchValue = selectboxOpts.DefaultValue
+		} else if v, ok := b.lookupQueryParam(selectboxOpts.QueryParam); ok {
+			if idx := slices.Index(selectboxOpts.Options, v[0]); idx >= 0 {
+				i := int32(idx)
+				selectboxState.Value = &i
+			}
+		}
 	}
 
 	if selectboxOpts.FormatFunc == nil {
@@ -71,14 +82,49 @@ func (b *uiBuilder) Selectbox(label string, opts ...selectbox.Option) *selectbox
 		displayVals[i] = selectboxOpts.FormatFunc(v, i)
 	}
 
+	var searchIndex int
+	if selectboxOpts.SearchFunc != nil {
+		formatFunc := selectboxOpts.FormatFunc
+		sess.Searches.Register(widgetID, selectboxOpts.SearchFunc, func(v string) string {
+			return formatFunc(v, -1)
+		})
+
+		selectboxState.Value = nil
+		selectboxState.SearchLabel = nil
+		if v := selectboxState.SearchValue; v != nil {
+			searchIndex = slices.Index(selectboxOpts.Options, *v)
+			label, known := sess.Searches.Label(widgetID, *v)
+			if searchIndex >= 0 || (!known && *v == ptrconv.StringValue(selectboxOpts.DefaultValue)) {
+				label, known = formatFunc(*v, searchIndex), true
+			}
+			if known {
+				selectboxState.SearchLabel = &label
+			} else {
+				selectboxState.SearchValue = nil
+			}
+		}
+	}
+
 	selectboxState.Label = selectboxOpts.Label
 	selectboxState.Options = displayVals
 	selectboxState.Placeholder = selectboxOpts.Placeholder
 	selectboxState.DefaultValue = defaultVal
 	selectboxState.Required = selectboxOpts.Required
 	selectboxState.Disabled = selectboxOpts.Disabled
+	selectboxState.Searchable = selectboxOpts.SearchFunc != nil
 	sess.State.Set(widgetID, selectboxState)
 
+	if !selectboxState.Searchable {
+		var values, defaultValues []string
+		if selectboxState.Value != nil {
+			values = []string{selectboxOpts.Options[*selectboxState.Value]}
+		}
+		if defaultVal != nil {
+			defaultValues = []string{selectboxOpts.Options[*defaultVal]}
+		}
+		b.bindQueryParam(selectboxOpts.QueryParam, values, slices.Equal(values, defaultValues))
+	}
+
 	selectboxProto := convertStateToSelectboxProto(selectboxState)
 	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
 		SessionId: sess.ID.String(),
@@ -95,7 +141,12 @@ func (b *uiBuilder) Selectbox(label string, opts ...selectbox.Option) *selectbox
 	cursor.next()
 
 	var value *selectbox.Value
-	if selectboxState.Value != nil {
+	if selectboxState.SearchValue != nil {
+		value = &selectbox.Value{
+			Value: *selectboxState.SearchValue,
+			Index: searchIndex,
+		}
+	} else if selectboxState.Value != nil {
 		value = &selectbox.Value{
 			Value: selectboxOpts.Options[*selectboxState.Value],
 			Index: int(*selectboxState.Value),
@@ -117,6 +168,9 @@ func convertStateToSelectboxProto(state *state.SelectboxState) *widgetv1.Selectb
 		DefaultValue: state.DefaultValue,
 		Required:     state.Required,
 		Disabled:     state.Disabled,
+		Searchable:   state.Searchable,
+		SearchValue:  state.SearchValue,
+		SearchLabel:  state.SearchLabel,
 	}
 }
 
@@ -133,5 +187,8 @@ func convertSelectboxProtoToState(id uuid.UUID, data *widgetv1.Selectbox) *state
 		DefaultValue: data.DefaultValue,
 		Required:     data.Required,
 		Disabled:     data.Disabled,
+		Searchable:   data.Searchable,
+		SearchValue:  data.SearchValue,
+		SearchLabel:  data.SearchLabel,
 	}
 }
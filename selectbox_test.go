@@ -2,10 +2,13 @@ package sourcetool
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid/v5"
 
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
 	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
 	"github.com/trysourcetool/sourcetool-go/internal/session"
 	"github.com/trysourcetool/sourcetool-go/internal/session/state"
@@ -227,3 +230,213 @@ func TestSelectbox_WithFormatFunc(t *testing.T) {
 		}
 	}
 }
+
+func TestRuntime_SelectboxSearch(t *testing.T) {
+	pageID := uuid.Must(uuid.NewV4())
+	sessionID := uuid.Must(uuid.NewV4())
+
+	customers := []string{"acme", "globex", "initech", "umbrella"}
+	search := func(ctx context.Context, query string) ([]selectbox.SearchResult, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			t.Error("search context has no deadline")
+		}
+		var matches []selectbox.SearchResult
+		for _, c := range customers {
+			if strings.HasPrefix(c, query) {
+				matches = append(matches, selectbox.SearchResult{Value: c})
+			}
+		}
+		return matches, nil
+	}
+
+	runs := 0
+	var got *selectbox.Value
+	pages := map[uuid.UUID]*page{
+		pageID: {
+			id: pageID,
+			handler: func(ui UIBuilder) error {
+				runs++
+				got = ui.Selectbox("Customer",
+					selectbox.WithSearch(search),
+					selectbox.WithFormatFunc(func(v string, i int) string {
+						return strings.ToUpper(v)
+					}),
+				)
+				return nil
+			},
+		},
+	}
+
+	mockClient := mock.NewClient()
+	r := &runtime{
+		wsClient:       mockClient,
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(pages),
+	}
+	mockClient.RegisterHandler(func(msg *websocketv1.Message) error {
+		switch m := msg.Type.(type) {
+		case *websocketv1.Message_RerunPage:
+			return r.handleRerunPage(m.RerunPage)
+		case *websocketv1.Message_SearchOptions:
+			return r.handleSearchOptions(msg.Id, m.SearchOptions)
+		}
+		return nil
+	})
+
+	sess := session.New(sessionID, pageID)
+	r.sessionManager.SetSession(sess)
+
+	mockClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RerunPage{
+		SessionId: sessionID.String(),
+		PageId:    pageID.String(),
+	})
+
+	ui := &uiBuilder{page: pages[pageID]}
+	widgetID := ui.generatePageID(state.WidgetTypeSelectbox, []int{0})
+
+	mockClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.SearchOptions{
+		SessionId: sessionID.String(),
+		WidgetId:  widgetID.String(),
+		Query:     "in",
+	})
+
+	// The search runs off the read loop, so wait for its result.
+	var result *websocketv1.SearchOptionsResult
+	for deadline := time.Now().Add(time.Second); result == nil && time.Now().Before(deadline); {
+		messages := mockClient.Messages()
+		result = messages[len(messages)-1].GetSearchOptionsResult()
+		if result == nil {
+			time.Sleep(time.Millisecond)
+		}
+	}
+	if result == nil {
+		t.Fatal("last message type = nil, want SearchOptionsResult")
+	}
+	if runs != 1 {
+		t.Errorf("page runs after search = %d, want 1", runs)
+	}
+	if len(result.Values) != 1 || result.Values[0] != "initech" {
+		t.Errorf("result values = %v, want [initech]", result.Values)
+	}
+	if len(result.Labels) != 1 || result.Labels[0] != "INITECH" {
+		t.Errorf("result labels = %v, want [INITECH]", result.Labels)
+	}
+	if result.Query != "in" {
+		t.Errorf("result query = %q, want %q", result.Query, "in")
+	}
+
+	selectSearchValue := func(v string) {
+		mockClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RerunPage{
+			SessionId: sessionID.String(),
+			PageId:    pageID.String(),
+			States: []*widgetv1.Widget{
+				{
+					Id: widgetID.String(),
+					Type: &widgetv1.Widget_Selectbox{
+						Selectbox: &widgetv1.Selectbox{
+							Label:       "Customer",
+							Searchable:  true,
+							SearchValue: &v,
+						},
+					},
+				},
+			},
+		})
+	}
+
+	selectSearchValue("initech")
+	if got == nil {
+		t.Fatal("Selectbox returned nil after selecting a searched value")
+	}
+	if got.Value != "initech" || got.Index != -1 {
+		t.Errorf("Selectbox value = %+v, want {initech -1}", *got)
+	}
+	if label := sess.State.GetSelectbox(widgetID).SearchLabel; label == nil || *label != "INITECH" {
+		t.Errorf("SearchLabel = %v, want INITECH", label)
+	}
+
+	// Values the host never returned are rejected.
+	selectSearchValue("umbrella")
+	if got != nil {
+		t.Errorf("Selectbox value = %+v, want nil for a value not returned by a search", *got)
+	}
+}
+
+func TestRuntime_SearchAfterClose(t *testing.T) {
+	pageID := uuid.Must(uuid.NewV4())
+	sessionID := uuid.Must(uuid.NewV4())
+
+	started := make(chan struct{})
+	finished := make(chan struct{})
+	search := func(ctx context.Context, query string) ([]selectbox.SearchResult, error) {
+		close(started)
+		defer close(finished)
+		<-ctx.Done()
+		return []selectbox.SearchResult{{Value: "acme"}}, nil
+	}
+	pages := map[uuid.UUID]*page{
+		pageID: {
+			id: pageID,
+			handler: func(ui UIBuilder) error {
+				ui.Selectbox("Customer", selectbox.WithSearch(search))
+				return nil
+			},
+		},
+	}
+
+	client := &closingClient{release: make(chan struct{})}
+	r := &runtime{
+		wsClient:       client,
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(pages),
+	}
+	sess := session.New(sessionID, pageID)
+	r.sessionManager.SetSession(sess)
+	if err := r.handleRerunPage(&websocketv1.RerunPage{
+		SessionId: sessionID.String(),
+		PageId:    pageID.String(),
+	}); err != nil {
+		t.Fatalf("handleRerunPage returned error: %v", err)
+	}
+
+	ui := &uiBuilder{page: pages[pageID]}
+	widgetID := ui.generatePageID(state.WidgetTypeSelectbox, []int{0})
+	searchMsg := &websocketv1.SearchOptions{
+		SessionId: sessionID.String(),
+		WidgetId:  widgetID.String(),
+		Query:     "a",
+	}
+	if err := r.handleSearchOptions(uuid.Must(uuid.NewV4()).String(), searchMsg); err != nil {
+		t.Fatalf("handleSearchOptions returned error: %v", err)
+	}
+	<-started
+
+	// Close cancels the running search and waits for it before closing the
+	// connection, so its result is never sent.
+	closed := make(chan error, 1)
+	go func() { closed <- r.Close() }()
+	select {
+	case err := <-closed:
+		if err != nil {
+			t.Fatalf("Close returned error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close did not cancel the running search")
+	}
+	select {
+	case <-finished:
+	default:
+		t.Error("Close returned before the running search finished")
+	}
+
+	if err := r.handleSearchOptions(uuid.Must(uuid.NewV4()).String(), searchMsg); err != nil {
+		t.Fatalf("handleSearchOptions after Close returned error: %v", err)
+	}
+	r.searches.Wait()
+
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	if client.sentOnClose != 0 {
+		t.Errorf("messages sent after Close = %d, want 0", client.sentOnClose)
+	}
+}
@@ -0,0 +1,139 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/slider"
+)
+
+func (b *uiBuilder) Slider(label string, opts ...slider.Option) float64 {
+	sliderOpts := &options.SliderOptions{
+		Label:        label,
+		DefaultValue: nil,
+		MinValue:     0,
+		MaxValue:     100,
+		Step:         1,
+		Format:       "",
+		Disabled:     false,
+	}
+
+	for _, o := range opts {
+		o.Apply(sliderOpts)
+	}
+	sliderOpts.MinValue, sliderOpts.MaxValue, sliderOpts.Step = normalizeSliderBounds(sliderOpts.MinValue, sliderOpts.MaxValue, sliderOpts.Step)
+
+	defaultValue := sliderOpts.MinValue
+	if sliderOpts.DefaultValue != nil {
+		defaultValue = clampFloat(*sliderOpts.DefaultValue, sliderOpts.MinValue, sliderOpts.MaxValue)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return defaultValue
+	}
+	page := b.page
+	if page == nil {
+		return defaultValue
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return defaultValue
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeSlider, path)
+	sliderState := sess.State.GetSlider(widgetID)
+	if sliderState == nil {
+		sliderState = &state.SliderState{
+			ID:    widgetID,
+			Value: defaultValue,
+		}
+	}
+	sliderState.Value = clampFloat(sliderState.Value, sliderOpts.MinValue, sliderOpts.MaxValue)
+	sliderState.Label = sliderOpts.Label
+	sliderState.DefaultValue = defaultValue
+	sliderState.MinValue = sliderOpts.MinValue
+	sliderState.MaxValue = sliderOpts.MaxValue
+	sliderState.Step = sliderOpts.Step
+	sliderState.Format = sliderOpts.Format
+	sliderState.Disabled = sliderOpts.Disabled
+	sess.State.Set(widgetID, sliderState)
+
+	slider := convertStateToSliderProto(sliderState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Slider{
+				Slider: slider,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return sliderState.Value
+}
+
+const defaultSliderStep = 1
+
+// normalizeSliderBounds swaps minValue and maxValue if they are reversed and
+// falls back to defaultSliderStep when step is not positive.
+func normalizeSliderBounds(minValue, maxValue, step float64) (float64, float64, float64) {
+	if minValue > maxValue {
+		minValue, maxValue = maxValue, minValue
+	}
+	if !(step > 0) {
+		step = defaultSliderStep
+	}
+	return minValue, maxValue, step
+}
+
+func clampFloat(v, minValue, maxValue float64) float64 {
+	if v < minValue {
+		return minValue
+	}
+	if v > maxValue {
+		return maxValue
+	}
+	return v
+}
+
+func convertStateToSliderProto(state *state.SliderState) *widgetv1.Slider {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Slider{
+		Value:        state.Value,
+		Label:        state.Label,
+		DefaultValue: state.DefaultValue,
+		MinValue:     state.MinValue,
+		MaxValue:     state.MaxValue,
+		Step:         state.Step,
+		Format:       state.Format,
+		Disabled:     state.Disabled,
+	}
+}
+
+func convertSliderProtoToState(id uuid.UUID, data *widgetv1.Slider) *state.SliderState {
+	if data == nil {
+		return nil
+	}
+	return &state.SliderState{
+		ID:           id,
+		Value:        data.Value,
+		Label:        data.Label,
+		DefaultValue: data.DefaultValue,
+		MinValue:     data.MinValue,
+		MaxValue:     data.MaxValue,
+		Step:         data.Step,
+		Format:       data.Format,
+		Disabled:     data.Disabled,
+	}
+}
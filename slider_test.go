@@ -0,0 +1,255 @@
+package sourcetool
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/slider"
+)
+
+func TestConvertStateToSliderProto(t *testing.T) {
+	sliderState := &state.SliderState{
+		ID:           uuid.Must(uuid.NewV4()),
+		Value:        42,
+		Label:        "Test Slider",
+		DefaultValue: 10,
+		MinValue:     0,
+		MaxValue:     50,
+		Step:         2,
+		Format:       "%.0f%%",
+		Disabled:     true,
+	}
+
+	data := convertStateToSliderProto(sliderState)
+
+	if data == nil {
+		t.Fatal("convertStateToSliderProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Value", data.Value, sliderState.Value},
+		{"Label", data.Label, sliderState.Label},
+		{"DefaultValue", data.DefaultValue, sliderState.DefaultValue},
+		{"MinValue", data.MinValue, sliderState.MinValue},
+		{"MaxValue", data.MaxValue, sliderState.MaxValue},
+		{"Step", data.Step, sliderState.Step},
+		{"Format", data.Format, sliderState.Format},
+		{"Disabled", data.Disabled, sliderState.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertSliderProtoToState(t *testing.T) {
+	data := &widgetv1.Slider{
+		Value:        0.5,
+		Label:        "Test Slider",
+		DefaultValue: 0.25,
+		MinValue:     0,
+		MaxValue:     1,
+		Step:         0.05,
+		Format:       "%.2f",
+		Disabled:     false,
+	}
+
+	state := convertSliderProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertSliderProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Value", state.Value, data.Value},
+		{"Label", state.Label, data.Label},
+		{"DefaultValue", state.DefaultValue, data.DefaultValue},
+		{"MinValue", state.MinValue, data.MinValue},
+		{"MaxValue", state.MaxValue, data.MaxValue},
+		{"Step", state.Step, data.Step},
+		{"Format", state.Format, data.Format},
+		{"Disabled", state.Disabled, data.Disabled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlider(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	label := "Test Slider"
+	value := builder.Slider(label,
+		slider.WithMinValue(10),
+		slider.WithMaxValue(20),
+		slider.WithStep(0.5),
+		slider.WithDefaultValue(15),
+		slider.WithFormat("%.1f"),
+	)
+
+	if value != 15 {
+		t.Errorf("Slider value = %v, want 15", value)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(messages))
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeSlider, []int{0})
+	state := sess.State.GetSlider(widgetID)
+	if state == nil {
+		t.Fatal("Slider state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Label", state.Label, label},
+		{"Value", state.Value, 15.0},
+		{"DefaultValue", state.DefaultValue, 15.0},
+		{"MinValue", state.MinValue, 10.0},
+		{"MaxValue", state.MaxValue, 20.0},
+		{"Step", state.Step, 0.5},
+		{"Format", state.Format, "%.1f"},
+		{"Disabled", state.Disabled, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlider_ClampsValue(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeSlider, []int{0})
+	sess.State.Set(widgetID, &state.SliderState{
+		ID:    widgetID,
+		Value: 150,
+	})
+
+	if got := builder.Slider("Volume"); got != 100 {
+		t.Errorf("Slider value = %v, want 100", got)
+	}
+}
+
+func TestNormalizeSliderBounds(t *testing.T) {
+	tests := []struct {
+		name                       string
+		minValue, maxValue, step   float64
+		wantMin, wantMax, wantStep float64
+	}{
+		{"Valid", 0, 10, 0.5, 0, 10, 0.5},
+		{"Reversed bounds", 10, 0, 1, 0, 10, 1},
+		{"Zero step", 0, 10, 0, 0, 10, 1},
+		{"Negative step", 0, 10, -2, 0, 10, 1},
+		{"NaN step", 0, 10, math.NaN(), 0, 10, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMin, gotMax, gotStep := normalizeSliderBounds(tt.minValue, tt.maxValue, tt.step)
+			if gotMin != tt.wantMin || gotMax != tt.wantMax || gotStep != tt.wantStep {
+				t.Errorf("normalizeSliderBounds() = (%v, %v, %v), want (%v, %v, %v)", gotMin, gotMax, gotStep, tt.wantMin, tt.wantMax, tt.wantStep)
+			}
+		})
+	}
+}
+
+func TestSlider_InvalidBounds(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mock.NewClient(),
+		},
+	}
+
+	got := builder.Slider("Volume",
+		slider.WithMinValue(10),
+		slider.WithMaxValue(0),
+		slider.WithStep(0),
+		slider.WithDefaultValue(5),
+	)
+	if got != 5 {
+		t.Errorf("Slider value = %v, want 5", got)
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeSlider, []int{0})
+	state := sess.State.GetSlider(widgetID)
+	if state.MinValue != 0 || state.MaxValue != 10 {
+		t.Errorf("bounds = [%v, %v], want [0, 10]", state.MinValue, state.MaxValue)
+	}
+	if state.Step != 1 {
+		t.Errorf("Step = %v, want 1", state.Step)
+	}
+}
@@ -1,13 +1,20 @@
 package sourcetool
 
 import (
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gofrs/uuid/v5"
+	"go.uber.org/zap"
 
 	"github.com/trysourcetool/sourcetool-go/internal/logger"
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket"
 )
 
 type Sourcetool struct {
@@ -15,81 +22,292 @@ type Sourcetool struct {
 	apiKey      string
 	environment string
 	endpoint    string
+	options     *options.SourcetoolOptions
 	runtime     *runtime
 	pages       map[uuid.UUID]*page
-	mu          sync.RWMutex
+	// pageErrs collects registration problems reported by Listen.
+	pageErrs []error
+	// pageCount numbers pages in registration order for their navigation path.
+	pageCount int
+	// listening is set while Listen runs, including before the runtime has
+	// connected, so pages added from then on are validated immediately.
+	listening bool
+	mu        sync.RWMutex
 }
 
-func New(config *Config) *Sourcetool {
+func New(config *Config, opts ...Option) (*Sourcetool, error) {
+	if config == nil {
+		return nil, errors.New("config is required")
+	}
 	hostParts := strings.Split(config.Endpoint, "://")
-	if len(hostParts) != 2 {
-		panic("invalid host")
+	if len(hostParts) != 2 || hostParts[1] == "" {
+		return nil, fmt.Errorf("invalid endpoint %q: want scheme://host", config.Endpoint)
 	}
 	namespaceDNS := strings.Split(hostParts[1], ":")[0]
+	if config.Namespace != "" {
+		namespaceDNS = config.Namespace
+	}
 	keyParts := strings.Split(config.APIKey, "_")
-	if len(keyParts) != 2 {
-		panic("invalid api key")
+	if len(keyParts) != 2 || keyParts[0] == "" || keyParts[1] == "" {
+		return nil, errors.New("invalid api key: want <environment>_<key>")
+	}
+
+	sourcetoolOpts := &options.SourcetoolOptions{
+		PingInterval:   1 * time.Second,
+		ReconnectDelay: 1 * time.Second,
+		QueueSize:      0,
+		Logger:         nil,
+		Location:       time.Local,
+	}
+
+	for _, o := range opts {
+		o.Apply(sourcetoolOpts)
+	}
+
+	// Check the connection options against the websocket client's limits
+	// now rather than when Listen connects.
+	if err := websocket.ValidateConfig(websocket.Config{
+		PingInterval:   sourcetoolOpts.PingInterval,
+		ReconnectDelay: sourcetoolOpts.ReconnectDelay,
+		QueueSize:      sourcetoolOpts.QueueSize,
+	}); err != nil {
+		return nil, err
 	}
+
 	s := &Sourcetool{
 		apiKey:      config.APIKey,
 		environment: keyParts[0],
 		endpoint:    fmt.Sprintf("%s/ws", config.Endpoint),
+		options:     sourcetoolOpts,
 		pages:       make(map[uuid.UUID]*page),
 	}
 	s.Router = newRouter(s, namespaceDNS)
-	return s
+	return s, nil
 }
 
 func (s *Sourcetool) Listen() error {
+	// From here on, pages are validated as they are added instead of being
+	// left for validatePages.
+	s.mu.Lock()
+	s.listening = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.listening = false
+		s.runtime = nil
+		s.mu.Unlock()
+	}()
+
 	if err := s.validatePages(); err != nil {
 		return err
 	}
 
-	if err := logger.Init(); err != nil {
+	if s.options.Logger != nil {
+		logger.Log = s.options.Logger
+	} else if err := logger.Init(); err != nil {
 		return fmt.Errorf("failed to initialize logger: %v", err)
 	}
 	defer logger.Sync()
 
 	s.mu.RLock()
-	r, err := startRuntime(s.apiKey, s.endpoint, s.pages)
+	pages := maps.Clone(s.pages)
 	s.mu.RUnlock()
+
+	r, err := startRuntime(s.apiKey, s.endpoint, pages, s.options)
 	if err != nil {
 		return err
 	}
 	defer r.Close()
 
+	// Pages added or removed while the runtime was connecting are sent as a
+	// manifest update.
+	s.mu.Lock()
 	s.runtime = r
+	changed := r.pageManager.syncPages(maps.Clone(s.pages))
+	s.mu.Unlock()
+	if changed {
+		r.pushManifest()
+	}
 
 	return r.wsClient.Wait()
 }
 
 func (s *Sourcetool) Close() error {
-	if s.runtime == nil {
+	s.mu.RLock()
+	r := s.runtime
+	s.mu.RUnlock()
+	if r == nil {
 		return nil
 	}
-	return s.runtime.Close()
+	return r.Close()
 }
 
-func (s *Sourcetool) validatePages() error {
+// RemovePage unregisters the page at route. After Listen, the page manifest
+// is sent again in the background so the page disappears from the navigation.
+func (s *Sourcetool) RemovePage(route string) error {
+	s.mu.Lock()
+	var removed *page
+	for id, p := range s.pages {
+		if p.route == route {
+			removed = p
+			delete(s.pages, id)
+			break
+		}
+	}
+	r := s.runtime
+	if removed != nil && r != nil {
+		r.pageManager.removePage(removed.id)
+	}
+	s.mu.Unlock()
+
+	if removed == nil {
+		return fmt.Errorf("page not found: %s", route)
+	}
+	if r != nil {
+		r.pushManifest()
+	}
+	return nil
+}
+
+// PageIDMigration maps the page IDs generated under oldNamespace to the
+// current page IDs, for updating saved links and permissions after changing
+// Config.Namespace or the endpoint. For apps that did not set a namespace,
+// oldNamespace is the hostname of the previous endpoint. Pages whose ID did
+// not change are omitted.
+func (s *Sourcetool) PageIDMigration(oldNamespace string) map[string]string {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	pagesByRoute := make(map[string]uuid.UUID)
+	migration := make(map[string]string)
 	for id, p := range s.pages {
-		pagesByRoute[p.route] = id
+		oldID := generatePageID(oldNamespace, s.environment, p.route)
+		if oldID != id {
+			migration[oldID.String()] = id.String()
+		}
+	}
+	return migration
+}
+
+// validatePages reports every page registration problem at once, so Listen
+// fails instead of serving a silently different set of pages.
+func (s *Sourcetool) validatePages() error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	errs := slices.Clone(s.pageErrs)
+
+	pages := slices.Collect(maps.Values(s.pages))
+	slices.SortFunc(pages, func(a, b *page) int {
+		return strings.Compare(a.route, b.route)
+	})
+	for i, p := range pages {
+		if i > 0 && pages[i-1].route == p.route {
+			errs = append(errs, fmt.Errorf("duplicate route %q: pages %q and %q", p.route, pages[i-1].name, p.name))
+		}
+		errs = append(errs, validatePage(p)...)
 	}
 
-	newPages := make(map[uuid.UUID]*page)
-	for _, id := range pagesByRoute {
-		newPages[id] = s.pages[id]
+	return errors.Join(errs...)
+}
+
+func validatePage(p *page) []error {
+	var errs []error
+	if strings.TrimSpace(p.name) == "" {
+		errs = append(errs, fmt.Errorf("page at route %q has an empty name", p.route))
+	}
+	if err := validateRoute(p.route); err != nil {
+		errs = append(errs, fmt.Errorf("page %q: %w", p.name, err))
 	}
-	s.pages = newPages
+	return errs
+}
 
+// validateRoute checks that route segments only use unreserved URL
+// characters, apart from the ":name" and trailing "*name" parameter prefixes.
+func validateRoute(route string) error {
+	if route == "/" {
+		return nil
+	}
+	segs := strings.Split(strings.Trim(route, "/"), "/")
+	seen := make(map[string]struct{})
+	for i, seg := range segs {
+		if seg == "" {
+			return fmt.Errorf("route %q: empty path segment", route)
+		}
+		name := seg
+		if strings.HasPrefix(seg, ":") || strings.HasPrefix(seg, "*") {
+			if strings.HasPrefix(seg, "*") && i != len(segs)-1 {
+				return fmt.Errorf("route %q: wildcard %q must be the last segment", route, seg)
+			}
+			name = seg[1:]
+			if name == "" {
+				return fmt.Errorf("route %q: parameter without a name", route)
+			}
+			if _, ok := seen[name]; ok {
+				return fmt.Errorf("route %q: duplicate parameter %q", route, name)
+			}
+			seen[name] = struct{}{}
+		}
+		for _, c := range name {
+			if !isRouteChar(c) {
+				return fmt.Errorf("route %q: invalid character %q", route, c)
+			}
+		}
+	}
 	return nil
 }
 
+func isRouteChar(c rune) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || strings.ContainsRune("-._~", c)
+}
+
+// addPage registers p. Before Listen, problems are collected for
+// validatePages; once Listen runs, an invalid page is logged and skipped, and
+// a valid one is pushed to the runtime with a fresh page manifest. Pages are
+// duplicates when they share a route, whatever their IDs, and an explicit ID
+// may not be reused on another route.
 func (s *Sourcetool) addPage(id uuid.UUID, p *page) {
 	s.mu.Lock()
+	errs := make([]error, 0)
+	replaced := uuid.Nil
+	for existingID, existing := range s.pages {
+		switch {
+		case existing.route == p.route:
+			errs = append(errs, fmt.Errorf("duplicate route %q: page %q replaces page %q", p.route, p.name, existing.name))
+			replaced = existingID
+		case existingID == id:
+			errs = append(errs, fmt.Errorf("page %q at route %q reuses the ID %s of page %q at route %q", p.name, p.route, id, existing.name, existing.route))
+		}
+	}
+	if s.listening {
+		errs = append(errs, validatePage(p)...)
+		if len(errs) > 0 {
+			s.mu.Unlock()
+			logger.Log.Error("failed to add page", zap.Error(errors.Join(errs...)))
+			return
+		}
+	}
+	s.pageErrs = append(s.pageErrs, errs...)
+	delete(s.pages, replaced)
+	p.path = []int{s.pageCount}
+	s.pageCount++
 	s.pages[id] = p
+	r := s.runtime
+	if r != nil {
+		r.pageManager.setPage(p)
+	}
 	s.mu.Unlock()
+
+	if r != nil {
+		r.pushManifest()
+	}
+}
+
+func (s *Sourcetool) addPageErr(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.listening {
+		logger.Log.Error("failed to add page", zap.Error(err))
+		return
+	}
+	s.pageErrs = append(s.pageErrs, err)
 }
@@ -2,11 +2,39 @@ package sourcetool
 
 import (
 	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid/v5"
+	gorillaws "github.com/gorilla/websocket"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/trysourcetool/sourcetool-go/internal/logger"
+	exceptionv1 "github.com/trysourcetool/sourcetool-go/internal/pb/exception/v1"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/pageoption"
 )
 
+func newTestSourcetool(t *testing.T, config *Config, opts ...Option) *Sourcetool {
+	t.Helper()
+	st, err := New(config, opts...)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	return st
+}
+
 func TestNew(t *testing.T) {
 	apiKey := "test_apikey"
 	endpoint := "ws://test.trysourcetool.com"
@@ -14,8 +42,10 @@ func TestNew(t *testing.T) {
 		APIKey:   apiKey,
 		Endpoint: endpoint,
 	}
-	st := New(config)
-
+	st, err := New(config)
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
 	if st == nil {
 		t.Fatal("New returned nil")
 	}
@@ -39,6 +69,175 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNew_InvalidConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *Config
+		opts   []Option
+	}{
+		{"Nil config", nil, nil},
+		{"Endpoint without scheme", &Config{APIKey: "test_apikey", Endpoint: "test.trysourcetool.com"}, nil},
+		{"Empty endpoint host", &Config{APIKey: "test_apikey", Endpoint: "ws://"}, nil},
+		{"API key without environment", &Config{APIKey: "apikey", Endpoint: "ws://test.trysourcetool.com"}, nil},
+		{"Empty API key part", &Config{APIKey: "test_", Endpoint: "ws://test.trysourcetool.com"}, nil},
+		{"Negative ping interval", &Config{APIKey: "test_apikey", Endpoint: "ws://test.trysourcetool.com"}, []Option{WithPingInterval(-time.Second)}},
+		{"Negative queue size", &Config{APIKey: "test_apikey", Endpoint: "ws://test.trysourcetool.com"}, []Option{WithQueueSize(-1)}},
+		{"Ping interval too short", &Config{APIKey: "test_apikey", Endpoint: "ws://test.trysourcetool.com"}, []Option{WithPingInterval(50 * time.Millisecond)}},
+		{"Ping interval too long", &Config{APIKey: "test_apikey", Endpoint: "ws://test.trysourcetool.com"}, []Option{WithPingInterval(time.Minute)}},
+		{"Reconnect delay too short", &Config{APIKey: "test_apikey", Endpoint: "ws://test.trysourcetool.com"}, []Option{WithReconnectDelay(10 * time.Millisecond)}},
+		{"Queue size too small", &Config{APIKey: "test_apikey", Endpoint: "ws://test.trysourcetool.com"}, []Option{WithQueueSize(10)}},
+		{"Queue size too large", &Config{APIKey: "test_apikey", Endpoint: "ws://test.trysourcetool.com"}, []Option{WithQueueSize(5000)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, err := New(tt.config, tt.opts...)
+			if err == nil {
+				t.Error("New returned nil error")
+			}
+			if st != nil {
+				t.Error("New returned non-nil Sourcetool")
+			}
+		})
+	}
+}
+
+func TestNew_Options(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skipf("time zone data not available: %v", err)
+	}
+	log := zap.NewNop()
+
+	st := newTestSourcetool(t, &Config{
+		APIKey:   "test_apikey",
+		Endpoint: "ws://test.trysourcetool.com",
+	},
+		WithPingInterval(5*time.Second),
+		WithReconnectDelay(2*time.Second),
+		WithQueueSize(500),
+		WithLogger(log),
+		WithLocation(*tokyo),
+	)
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"PingInterval", st.options.PingInterval, 5 * time.Second},
+		{"ReconnectDelay", st.options.ReconnectDelay, 2 * time.Second},
+		{"QueueSize", st.options.QueueSize, 500},
+		{"Logger", st.options.Logger, log},
+		{"Location", st.options.Location.String(), "Asia/Tokyo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSourcetool_ListenInitializeHostFails(t *testing.T) {
+	upgrader := gorillaws.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var msg websocketv1.Message
+		if err := proto.Unmarshal(data, &msg); err != nil {
+			return
+		}
+		resp, _ := websocket.NewMessage(msg.Id, &exceptionv1.Exception{Message: "invalid api key"})
+		data, _ = proto.Marshal(resp)
+		conn.WriteMessage(gorillaws.BinaryMessage, data)
+		// Close normally so the client does not try to reconnect.
+		conn.WriteMessage(gorillaws.CloseMessage, gorillaws.FormatCloseMessage(gorillaws.CloseNormalClosure, ""))
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	st := newTestSourcetool(t, &Config{
+		APIKey:   "test_apikey",
+		Endpoint: "ws" + strings.TrimPrefix(server.URL, "http"),
+	}, WithLogger(zap.NewNop()))
+	st.Page("/users", "Users", func(ui UIBuilder) error { return nil })
+
+	err := st.Listen()
+	if err == nil || !strings.Contains(err.Error(), "invalid api key") {
+		t.Errorf("Listen() = %v, want the initialize host error", err)
+	}
+}
+
+func TestNewFromEnv(t *testing.T) {
+	t.Run("Missing API key", func(t *testing.T) {
+		t.Setenv(envAPIKey, "")
+		t.Setenv(envEndpoint, "ws://test.trysourcetool.com")
+		if _, err := NewFromEnv(); err == nil {
+			t.Error("NewFromEnv returned nil error")
+		}
+	})
+
+	t.Run("Invalid duration", func(t *testing.T) {
+		t.Setenv(envAPIKey, "test_apikey")
+		t.Setenv(envEndpoint, "ws://test.trysourcetool.com")
+		t.Setenv(envPingInterval, "often")
+		if _, err := NewFromEnv(); err == nil {
+			t.Error("NewFromEnv returned nil error")
+		}
+	})
+
+	t.Run("Full configuration", func(t *testing.T) {
+		t.Setenv(envAPIKey, "test_apikey")
+		t.Setenv(envEndpoint, "wss://sourcetool.internal.example.com")
+		t.Setenv(envNamespace, "test.trysourcetool.com")
+		t.Setenv(envPingInterval, "3s")
+		t.Setenv(envReconnectDelay, "4s")
+		t.Setenv(envQueueSize, "100")
+		t.Setenv(envTimezone, "UTC")
+
+		st, err := NewFromEnv(WithQueueSize(200))
+		if err != nil {
+			t.Fatalf("NewFromEnv returned error: %v", err)
+		}
+		st.Page("/users", "Users", func(ui UIBuilder) error { return nil })
+
+		tests := []struct {
+			name string
+			got  any
+			want any
+		}{
+			{"Endpoint", st.endpoint, "wss://sourcetool.internal.example.com/ws"},
+			{"Page ID", findPageByPath(st.pages, "/users").id, generatePageID("test.trysourcetool.com", "test", "/users")},
+			{"PingInterval", st.options.PingInterval, 3 * time.Second},
+			{"ReconnectDelay", st.options.ReconnectDelay, 4 * time.Second},
+			{"QueueSize overridden by option", st.options.QueueSize, 200},
+			{"Location", st.options.Location.String(), "UTC"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				if tt.got != tt.want {
+					t.Errorf("got %v, want %v", tt.got, tt.want)
+				}
+			})
+		}
+	})
+}
+
 func TestPage(t *testing.T) {
 	pageHandler := func(ui UIBuilder) error { return nil }
 
@@ -47,7 +246,7 @@ func TestPage(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		st.Page("/public", "Public Page", pageHandler)
 
 		page := findPageByPath(st.pages, "/public")
@@ -69,7 +268,7 @@ func TestPage(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		st.AccessGroups("admin")
 		st.Page("/admin", "Admin Page", pageHandler)
 
@@ -88,7 +287,7 @@ func TestPage(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		api := st.Group("/api")
 		api.AccessGroups("api_user")
 		api.Page("/users", "Users API", pageHandler)
@@ -115,7 +314,7 @@ func TestPage(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		users := st.Group("/users")
 		users.AccessGroups("admin")
 		users.Page("/list", "List users page", pageHandler)
@@ -170,7 +369,7 @@ func TestPage(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 
 		admin := st.Group("/admin")
 		admin.AccessGroups("admin")
@@ -234,7 +433,7 @@ func TestPage(t *testing.T) {
 			APIKey:   "test_apikey",
 			Endpoint: "ws://test.trysourcetool.com",
 		}
-		st := New(config)
+		st := newTestSourcetool(t, config)
 		errorHandler := func(ui UIBuilder) error {
 			return errors.New("test error")
 		}
@@ -289,3 +488,511 @@ func TestPageManager(t *testing.T) {
 		}
 	})
 }
+
+func TestValidatePages(t *testing.T) {
+	pageHandler := func(ui UIBuilder) error { return nil }
+	config := &Config{
+		APIKey:   "test_apikey",
+		Endpoint: "ws://test.trysourcetool.com",
+	}
+
+	t.Run("Valid pages", func(t *testing.T) {
+		st := newTestSourcetool(t, config)
+		st.Page("/users", "Users", pageHandler)
+		st.Page("/users/:id", "User", pageHandler)
+		st.Page("/files/*path", "Files", pageHandler)
+
+		if err := st.validatePages(); err != nil {
+			t.Errorf("validatePages() = %v, want nil", err)
+		}
+	})
+
+	t.Run("Invalid pages", func(t *testing.T) {
+		st := newTestSourcetool(t, config)
+		st.Page("/users", "Users", pageHandler)
+		st.Page("/users", "Members", pageHandler)
+		st.Page("/", "Home", pageHandler)
+		st.Page("/empty", " ", pageHandler)
+		st.Page("/bad path", "Bad", pageHandler)
+		st.Page("/files/*path/more", "Files", pageHandler)
+
+		err := st.validatePages()
+		if err == nil {
+			t.Fatal("validatePages() = nil, want error")
+		}
+		for _, want := range []string{
+			`duplicate route "/users"`,
+			`root path "/"`,
+			`route "/empty" has an empty name`,
+			`invalid character ' '`,
+			`wildcard "*path" must be the last segment`,
+		} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("validatePages() error %q does not contain %q", err, want)
+			}
+		}
+	})
+}
+
+func TestValidatePages_ExplicitIDs(t *testing.T) {
+	pageHandler := func(ui UIBuilder) error { return nil }
+	config := &Config{
+		APIKey:   "test_apikey",
+		Endpoint: "ws://test.trysourcetool.com",
+	}
+	id1 := uuid.Must(uuid.NewV4())
+	id2 := uuid.Must(uuid.NewV4())
+
+	t.Run("Same route with different IDs", func(t *testing.T) {
+		st := newTestSourcetool(t, config)
+		st.Page("/users", "Users", pageHandler, pageoption.WithID(id1.String()))
+		st.Page("/users", "Members", pageHandler, pageoption.WithID(id2.String()))
+
+		if len(st.pages) != 1 {
+			t.Errorf("pages = %d, want 1", len(st.pages))
+		}
+		if page := findPageByPath(st.pages, "/users"); page == nil || page.id != id2 {
+			t.Errorf("page at /users = %+v, want the page with ID %s", page, id2)
+		}
+		err := st.validatePages()
+		if err == nil || !strings.Contains(err.Error(), `duplicate route "/users"`) {
+			t.Errorf("validatePages() = %v, want duplicate route error", err)
+		}
+	})
+
+	t.Run("Same ID on different routes", func(t *testing.T) {
+		st := newTestSourcetool(t, config)
+		st.Page("/users", "Users", pageHandler, pageoption.WithID(id1.String()))
+		st.Page("/members", "Members", pageHandler, pageoption.WithID(id1.String()))
+
+		err := st.validatePages()
+		if err == nil || !strings.Contains(err.Error(), fmt.Sprintf(`reuses the ID %s of page "Users" at route "/users"`, id1)) {
+			t.Errorf("validatePages() = %v, want reused ID error", err)
+		}
+	})
+
+	t.Run("Same ID on different routes after Listen", func(t *testing.T) {
+		st := newTestSourcetool(t, config)
+		st.Page("/users", "Users", pageHandler, pageoption.WithID(id1.String()))
+		logger.Log = zap.NewNop()
+		st.listening = true
+		st.Page("/members", "Members", pageHandler, pageoption.WithID(id1.String()))
+		st.Page("/users", "Users Again", pageHandler, pageoption.WithID(id2.String()))
+
+		if len(st.pages) != 1 {
+			t.Fatalf("pages = %d, want 1", len(st.pages))
+		}
+		if page := st.pages[id1]; page == nil || page.route != "/users" || page.name != "Users" {
+			t.Errorf("page %s = %+v, want the original /users page", id1, page)
+		}
+	})
+
+	t.Run("Registered pages sharing a route", func(t *testing.T) {
+		st := newTestSourcetool(t, config)
+		st.pages[id1] = &page{id: id1, name: "Users", route: "/users"}
+		st.pages[id2] = &page{id: id2, name: "Members", route: "/users"}
+
+		err := st.validatePages()
+		if err == nil || !strings.Contains(err.Error(), `duplicate route "/users"`) {
+			t.Errorf("validatePages() = %v, want duplicate route error", err)
+		}
+	})
+}
+
+func TestValidateRoute(t *testing.T) {
+	tests := []struct {
+		route   string
+		wantErr bool
+	}{
+		{"/", false},
+		{"/users", false},
+		{"/users/:id/edit", false},
+		{"/docs/v1.2/~draft_x", false},
+		{"/files/*path", false},
+		{"/users/:", true},
+		{"/users/:id/:id", true},
+		{"/users//list", true},
+		{"/users?tab=1", true},
+		{"/ユーザー", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.route, func(t *testing.T) {
+			if err := validateRoute(tt.route); (err != nil) != tt.wantErr {
+				t.Errorf("validateRoute(%q) error = %v, wantErr %v", tt.route, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSourcetool_PagesAfterListen(t *testing.T) {
+	logger.Log = zap.NewNop()
+
+	config := &Config{
+		APIKey:   "test_apikey",
+		Endpoint: "ws://test.trysourcetool.com",
+	}
+	st := newTestSourcetool(t, config)
+	pageHandler := func(ui UIBuilder) error { return nil }
+	st.Page("/users", "Users", pageHandler)
+
+	mockWS := mock.NewClient()
+	st.listening = true
+	st.runtime = &runtime{
+		wsClient:       mockWS,
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(maps.Clone(st.pages)),
+		apiKey:         config.APIKey,
+	}
+
+	lastManifest := func() []string {
+		st.runtime.manifestPushes.Wait()
+		messages := mockWS.Messages()
+		if len(messages) == 0 {
+			return nil
+		}
+		host := messages[len(messages)-1].GetInitializeHost()
+		if host == nil {
+			t.Fatal("WebSocket message type = nil, want InitializeHost")
+		}
+		routes := make([]string, len(host.Pages))
+		for i, p := range host.Pages {
+			routes[i] = p.Route
+		}
+		return routes
+	}
+
+	st.Page("/tenant/reports", "Reports", pageHandler)
+	if st.runtime.pageManager.getPageByRoute("/tenant/reports") == nil {
+		t.Error("page added after Listen not found in page manager")
+	}
+	if got, want := lastManifest(), []string{"/users", "/tenant/reports"}; !slices.Equal(got, want) {
+		t.Errorf("manifest routes = %v, want %v", got, want)
+	}
+
+	// Invalid pages are skipped without a manifest update.
+	st.Page("/users", "Duplicate", pageHandler)
+	st.Page("/bad path", "Bad", pageHandler)
+	st.runtime.manifestPushes.Wait()
+	if n := len(mockWS.Messages()); n != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", n)
+	}
+	if got := st.runtime.pageManager.getPageByRoute("/users"); got == nil || got.name != "Users" {
+		t.Errorf("page at /users = %v, want Users", got)
+	}
+
+	if err := st.RemovePage("/users"); err != nil {
+		t.Fatalf("RemovePage returned error: %v", err)
+	}
+	if st.runtime.pageManager.getPageByRoute("/users") != nil {
+		t.Error("removed page still in page manager")
+	}
+	if got, want := lastManifest(), []string{"/tenant/reports"}; !slices.Equal(got, want) {
+		t.Errorf("manifest routes = %v, want %v", got, want)
+	}
+
+	if err := st.RemovePage("/users"); err == nil {
+		t.Error("RemovePage of missing page returned nil error")
+	}
+}
+
+// readLoopClient delivers messages to the handler on a single goroutine and
+// answers EnqueueWithResponse from that same goroutine, like the real client.
+// A handler that waits for a response therefore never gets one.
+type readLoopClient struct {
+	handler  websocket.MessageHandlerFunc
+	incoming chan *websocketv1.Message
+	mu       sync.Mutex
+	waiting  map[string]chan *websocketv1.Message
+	sent     []*websocketv1.Message
+}
+
+func newReadLoopClient() *readLoopClient {
+	c := &readLoopClient{
+		incoming: make(chan *websocketv1.Message, 16),
+		waiting:  make(map[string]chan *websocketv1.Message),
+	}
+	go func() {
+		for msg := range c.incoming {
+			c.mu.Lock()
+			respCh, ok := c.waiting[msg.Id]
+			c.mu.Unlock()
+			if ok {
+				respCh <- msg
+				continue
+			}
+			c.handler(msg)
+		}
+	}()
+	return c
+}
+
+func (c *readLoopClient) RegisterHandler(handler websocket.MessageHandlerFunc) {
+	c.handler = handler
+}
+
+func (c *readLoopClient) Enqueue(id string, payload proto.Message) {
+	msg, _ := websocket.NewMessage(id, payload)
+	c.mu.Lock()
+	c.sent = append(c.sent, msg)
+	c.mu.Unlock()
+}
+
+func (c *readLoopClient) EnqueueWithResponse(id string, payload proto.Message) (*websocketv1.Message, error) {
+	c.Enqueue(id, payload)
+	respCh := make(chan *websocketv1.Message, 1)
+	c.mu.Lock()
+	c.waiting[id] = respCh
+	c.mu.Unlock()
+	resp, _ := websocket.NewMessage(id, payload)
+	c.incoming <- resp
+	return <-respCh, nil
+}
+
+func (c *readLoopClient) Close() error { return nil }
+func (c *readLoopClient) Wait() error  { return nil }
+
+func TestSourcetool_AddPageFromHandler(t *testing.T) {
+	logger.Log = zap.NewNop()
+
+	st := newTestSourcetool(t, &Config{
+		APIKey:   "test_apikey",
+		Endpoint: "ws://test.trysourcetool.com",
+	})
+	pageHandler := func(ui UIBuilder) error { return nil }
+	st.Page("/admin", "Admin", func(ui UIBuilder) error {
+		st.Page("/tenant/reports", "Reports", pageHandler)
+		return st.RemovePage("/tenant/old")
+	})
+	st.Page("/tenant/old", "Old", pageHandler)
+
+	client := newReadLoopClient()
+	st.listening = true
+	st.runtime = &runtime{
+		wsClient:       client,
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(maps.Clone(st.pages)),
+		apiKey:         "test_apikey",
+	}
+	r := st.runtime
+	client.RegisterHandler(func(msg *websocketv1.Message) error {
+		if t := msg.GetRerunPage(); t != nil {
+			return r.handleRerunPage(t)
+		}
+		return nil
+	})
+
+	sessionID := uuid.Must(uuid.NewV4())
+	adminID := findPageByPath(st.pages, "/admin").id
+	r.sessionManager.SetSession(session.New(sessionID, adminID))
+
+	rerun, _ := websocket.NewMessage(uuid.Must(uuid.NewV4()).String(), &websocketv1.RerunPage{
+		SessionId: sessionID.String(),
+		PageId:    adminID.String(),
+	})
+	client.incoming <- rerun
+
+	finished := func() bool {
+		client.mu.Lock()
+		defer client.mu.Unlock()
+		for _, msg := range client.sent {
+			if msg.GetScriptFinished() != nil {
+				return true
+			}
+		}
+		return false
+	}
+	done := make(chan struct{})
+	go func() {
+		for !finished() {
+			time.Sleep(time.Millisecond)
+		}
+		r.manifestPushes.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("adding a page from a handler did not finish, want no deadlock")
+	}
+
+	if r.pageManager.getPageByRoute("/tenant/old") != nil {
+		t.Error("page removed from a handler is still registered")
+	}
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	var host *websocketv1.InitializeHost
+	for _, msg := range client.sent {
+		if h := msg.GetInitializeHost(); h != nil {
+			host = h
+		}
+	}
+	if host == nil {
+		t.Fatal("no page manifest was sent")
+	}
+	routes := make([]string, len(host.Pages))
+	for i, p := range host.Pages {
+		routes[i] = p.Route
+	}
+	if want := []string{"/admin", "/tenant/reports"}; !slices.Equal(routes, want) {
+		t.Errorf("manifest routes = %v, want %v", routes, want)
+	}
+}
+
+// closingClient holds every EnqueueWithResponse until release is closed and
+// records sends that arrive after Close.
+type closingClient struct {
+	release     chan struct{}
+	mu          sync.Mutex
+	sent        int
+	closed      bool
+	sentOnClose int
+}
+
+func (c *closingClient) RegisterHandler(handler websocket.MessageHandlerFunc) {}
+
+func (c *closingClient) Enqueue(id string, payload proto.Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		c.sentOnClose++
+	}
+	c.sent++
+}
+
+func (c *closingClient) EnqueueWithResponse(id string, payload proto.Message) (*websocketv1.Message, error) {
+	<-c.release
+	c.Enqueue(id, payload)
+	return websocket.NewMessage(id, payload)
+}
+
+func (c *closingClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
+
+func (c *closingClient) Wait() error { return nil }
+
+func TestSourcetool_PagesAfterClose(t *testing.T) {
+	logger.Log = zap.NewNop()
+
+	st := newTestSourcetool(t, &Config{
+		APIKey:   "test_apikey",
+		Endpoint: "ws://test.trysourcetool.com",
+	})
+	pageHandler := func(ui UIBuilder) error { return nil }
+	st.Page("/users", "Users", pageHandler)
+
+	client := &closingClient{release: make(chan struct{})}
+	st.listening = true
+	st.runtime = &runtime{
+		wsClient:       client,
+		sessionManager: session.NewSessionManager(),
+		pageManager:    newPageManager(maps.Clone(st.pages)),
+		apiKey:         "test_apikey",
+	}
+
+	// The manifest update is held by the client, so Close has to wait for it.
+	st.Page("/reports", "Reports", pageHandler)
+	closed := make(chan error, 1)
+	go func() { closed <- st.Close() }()
+	select {
+	case <-closed:
+		t.Fatal("Close returned while a manifest update was in flight")
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(client.release)
+	select {
+	case err := <-closed:
+		if err != nil {
+			t.Fatalf("Close returned error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close did not return after the manifest update finished")
+	}
+
+	// Pages changed after Close are not pushed to the closed connection.
+	st.Page("/settings", "Settings", pageHandler)
+	if err := st.RemovePage("/users"); err != nil {
+		t.Fatalf("RemovePage returned error: %v", err)
+	}
+	if err := st.Close(); err != nil {
+		t.Errorf("second Close returned error: %v", err)
+	}
+
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	if client.sent != 1 {
+		t.Errorf("messages sent = %d, want 1", client.sent)
+	}
+	if client.sentOnClose != 0 {
+		t.Errorf("messages sent after Close = %d, want 0", client.sentOnClose)
+	}
+}
+
+func TestPageIDs(t *testing.T) {
+	pageHandler := func(ui UIBuilder) error { return nil }
+
+	t.Run("Namespace", func(t *testing.T) {
+		old := newTestSourcetool(t, &Config{
+			APIKey:   "test_apikey",
+			Endpoint: "ws://test.trysourcetool.com",
+		})
+		moved := newTestSourcetool(t, &Config{
+			APIKey:    "test_apikey",
+			Endpoint:  "wss://sourcetool.internal.example.com",
+			Namespace: "test.trysourcetool.com",
+		})
+		old.Page("/users", "Users", pageHandler)
+		moved.Page("/users", "Users", pageHandler)
+
+		if a, b := findPageByPath(old.pages, "/users").id, findPageByPath(moved.pages, "/users").id; a != b {
+			t.Errorf("page ID with namespace = %s, want %s", b, a)
+		}
+	})
+
+	t.Run("WithID", func(t *testing.T) {
+		st := newTestSourcetool(t, &Config{
+			APIKey:   "test_apikey",
+			Endpoint: "ws://test.trysourcetool.com",
+		})
+		id := uuid.Must(uuid.NewV4())
+		st.Page("/users", "Users", pageHandler, pageoption.WithID(id.String()))
+		st.Page("/invalid", "Invalid", pageHandler, pageoption.WithID("not-a-uuid"))
+
+		if got := findPageByPath(st.pages, "/users").id; got != id {
+			t.Errorf("page ID = %s, want %s", got, id)
+		}
+		if findPageByPath(st.pages, "/invalid") != nil {
+			t.Error("page with invalid ID was registered")
+		}
+		if err := st.validatePages(); err == nil || !strings.Contains(err.Error(), `invalid ID "not-a-uuid"`) {
+			t.Errorf("validatePages() = %v, want invalid ID error", err)
+		}
+	})
+
+	t.Run("PageIDMigration", func(t *testing.T) {
+		st := newTestSourcetool(t, &Config{
+			APIKey:    "test_apikey",
+			Endpoint:  "wss://sourcetool.internal.example.com",
+			Namespace: "sourcetool.internal.example.com",
+		})
+		st.Page("/users", "Users", pageHandler)
+		st.Page("/reports", "Reports", pageHandler)
+
+		migration := st.PageIDMigration("test.trysourcetool.com")
+		if len(migration) != 2 {
+			t.Fatalf("PageIDMigration() length = %d, want 2", len(migration))
+		}
+		oldID := generatePageID("test.trysourcetool.com", "test", "/users").String()
+		if got, want := migration[oldID], findPageByPath(st.pages, "/users").id.String(); got != want {
+			t.Errorf("PageIDMigration()[%s] = %s, want %s", oldID, got, want)
+		}
+
+		if got := st.PageIDMigration("sourcetool.internal.example.com"); len(got) != 0 {
+			t.Errorf("PageIDMigration() with current namespace = %v, want empty", got)
+		}
+	})
+}
@@ -0,0 +1,135 @@
+package sourcetool
+
+import (
+	"sync"
+
+	"github.com/gofrs/uuid/v5"
+
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+)
+
+// Spinner is a handle to the spinner shown by UIBuilder.Spinner while its
+// function runs. Update changes the label in place.
+type Spinner struct {
+	builder *uiBuilder
+	path    path
+	state   *state.SpinnerState
+	mu      sync.Mutex
+}
+
+// Spinner shows a spinner with label while fn runs and stops it when fn
+// returns, including when fn fails. It returns the error of fn.
+func (b *uiBuilder) Spinner(label string, fn func(*Spinner) error) error {
+	sess := b.session
+	if sess == nil {
+		return fn(&Spinner{})
+	}
+	page := b.page
+	if page == nil {
+		return fn(&Spinner{})
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return fn(&Spinner{})
+	}
+	path := cursor.getPath()
+
+	widgetID := b.generatePageID(state.WidgetTypeSpinner, path)
+	spinnerState := sess.State.GetSpinner(widgetID)
+	if spinnerState == nil {
+		spinnerState = &state.SpinnerState{
+			ID: widgetID,
+		}
+	}
+	spinnerState.Label = label
+	spinnerState.Done = false
+	sess.State.Set(widgetID, spinnerState)
+
+	s := &Spinner{
+		builder: b,
+		path:    path,
+		state:   spinnerState,
+	}
+	s.render()
+
+	cursor.next()
+
+	defer s.done()
+	return fn(s)
+}
+
+// Update replaces the label shown next to the spinner. It has no effect once
+// the function passed to UIBuilder.Spinner has returned, and returns
+// ErrRunFinished once the page handler has returned.
+func (s *Spinner) Update(label string) error {
+	if s.builder == nil {
+		return nil
+	}
+	if s.builder.runFinished() {
+		return ErrRunFinished
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.state.Done {
+		return nil
+	}
+
+	s.state.Label = label
+	s.builder.session.State.Set(s.state.ID, s.state)
+	s.render()
+
+	return nil
+}
+
+// done stops the spinner. Nothing is sent once the page handler has
+// returned, since the host has already received ScriptFinished.
+func (s *Spinner) done() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.state.Done = true
+	s.builder.session.State.Set(s.state.ID, s.state)
+	if !s.builder.runFinished() {
+		s.render()
+	}
+}
+
+func (s *Spinner) render() {
+	b := s.builder
+	spinner := convertStateToSpinnerProto(s.state)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: b.session.ID.String(),
+		PageId:    b.page.id.String(),
+		Path:      convertPathToInt32Slice(s.path),
+		Widget: &widgetv1.Widget{
+			Id: s.state.ID.String(),
+			Type: &widgetv1.Widget_Spinner{
+				Spinner: spinner,
+			},
+		},
+	})
+}
+
+func convertStateToSpinnerProto(state *state.SpinnerState) *widgetv1.Spinner {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Spinner{
+		Label: state.Label,
+		Done:  state.Done,
+	}
+}
+
+func convertSpinnerProtoToState(id uuid.UUID, data *widgetv1.Spinner) *state.SpinnerState {
+	if data == nil {
+		return nil
+	}
+	return &state.SpinnerState{
+		ID:    id,
+		Label: data.Label,
+		Done:  data.Done,
+	}
+}
@@ -0,0 +1,194 @@
+package sourcetool
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestConvertStateToSpinnerProto(t *testing.T) {
+	spinnerState := &state.SpinnerState{
+		ID:    uuid.Must(uuid.NewV4()),
+		Label: "Loading",
+		Done:  true,
+	}
+
+	data := convertStateToSpinnerProto(spinnerState)
+
+	if data == nil {
+		t.Fatal("convertStateToSpinnerProto returned nil")
+	}
+	if data.Label != spinnerState.Label {
+		t.Errorf("Label = %v, want %v", data.Label, spinnerState.Label)
+	}
+	if data.Done != spinnerState.Done {
+		t.Errorf("Done = %v, want %v", data.Done, spinnerState.Done)
+	}
+}
+
+func TestConvertSpinnerProtoToState(t *testing.T) {
+	data := &widgetv1.Spinner{
+		Label: "Loading",
+		Done:  true,
+	}
+
+	state := convertSpinnerProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertSpinnerProtoToState returned nil")
+	}
+	if state.Label != data.Label {
+		t.Errorf("Label = %v, want %v", state.Label, data.Label)
+	}
+	if state.Done != data.Done {
+		t.Errorf("Done = %v, want %v", state.Done, data.Done)
+	}
+}
+
+func TestSpinner(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeSpinner, []int{0})
+
+	err := builder.Spinner("Loading", func(s *Spinner) error {
+		state := sess.State.GetSpinner(widgetID)
+		if state == nil {
+			t.Fatal("Spinner state not found")
+		}
+		if state.Done {
+			t.Error("Done = true while running, want false")
+		}
+		return s.Update("Still loading")
+	})
+	if err != nil {
+		t.Fatalf("Spinner() error = %v", err)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 3 {
+		t.Fatalf("WebSocket messages count = %d, want 3", len(messages))
+	}
+	last := messages[2].GetRenderWidget()
+	if last.Widget.Id != widgetID.String() {
+		t.Errorf("updated widget ID = %v, want %v", last.Widget.Id, widgetID)
+	}
+	if got := messages[1].GetRenderWidget().Widget.GetSpinner().Label; got != "Still loading" {
+		t.Errorf("updated Spinner label = %q, want %q", got, "Still loading")
+	}
+	if !last.Widget.GetSpinner().Done {
+		t.Error("final Spinner render Done = false, want true")
+	}
+	if !sess.State.GetSpinner(widgetID).Done {
+		t.Error("Done = false, want true")
+	}
+}
+
+func TestSpinner_Error(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	wantErr := errors.New("export failed")
+	var handle *Spinner
+	err := builder.Spinner("Exporting", func(s *Spinner) error {
+		handle = s
+		return wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("Spinner() error = %v, want %v", err, wantErr)
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeSpinner, []int{0})
+	if !sess.State.GetSpinner(widgetID).Done {
+		t.Error("Done = false after fn failed, want true")
+	}
+	messages := mockWS.Messages()
+	if len(messages) != 2 || !messages[1].GetRenderWidget().Widget.GetSpinner().Done {
+		t.Fatalf("WebSocket messages = %v, want a final render with Done = true", messages)
+	}
+
+	// Updates after fn returned do not restart the spinner.
+	if err := handle.Update("Late"); err != nil {
+		t.Errorf("Update() error = %v", err)
+	}
+	if len(mockWS.Messages()) != 2 {
+		t.Errorf("WebSocket messages count = %d, want 2", len(mockWS.Messages()))
+	}
+}
+
+func TestSpinner_AfterRunFinished(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	builder := &uiBuilder{
+		context: ctx,
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	err := builder.Spinner("Loading", func(s *Spinner) error {
+		cancel()
+		if err := s.Update("Still loading"); !errors.Is(err, ErrRunFinished) {
+			t.Errorf("Update() error = %v, want %v", err, ErrRunFinished)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Spinner() error = %v", err)
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeSpinner, []int{0})
+	if !sess.State.GetSpinner(widgetID).Done {
+		t.Error("Done = false, want true")
+	}
+	if len(mockWS.Messages()) != 1 {
+		t.Errorf("WebSocket messages count = %d, want 1", len(mockWS.Messages()))
+	}
+}
@@ -51,6 +51,9 @@ func (b *uiBuilder) TextArea(label string, opts ...textarea.Option) string {
 			ID:    widgetID,
 			Value: textAreaOpts.DefaultValue,
 		}
+		if v, ok := b.lookupQueryParam(textAreaOpts.QueryParam); ok {
+			textAreaState.Value = &v[0]
+		}
 	}
 	textAreaState.Label = textAreaOpts.Label
 	textAreaState.Placeholder = textAreaOpts.Placeholder
@@ -64,6 +67,9 @@ func (b *uiBuilder) TextArea(label string, opts ...textarea.Option) string {
 	textAreaState.AutoResize = textAreaOpts.AutoResize
 	sess.State.Set(widgetID, textAreaState)
 
+	value := ptrconv.StringValue(textAreaState.Value)
+	b.bindQueryParam(textAreaOpts.QueryParam, []string{value}, value == ptrconv.StringValue(textAreaOpts.DefaultValue))
+
 	textAreaProto := convertStateToTextAreaProto(textAreaState)
 	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
 		SessionId: sess.ID.String(),
@@ -95,3 +95,15 @@ func (a autoResizeOption) Apply(opts *options.TextAreaOptions) {
 func WithAutoResize(autoResize bool) Option {
 	return autoResizeOption(autoResize)
 }
+
+type queryParamOption string
+
+func (q queryParamOption) Apply(opts *options.TextAreaOptions) {
+	opts.QueryParam = string(q)
+}
+
+// WithQueryParam binds the value to the key query parameter of the page URL.
+// The parameter sets the initial value and is updated when the value changes.
+func WithQueryParam(key string) Option {
+	return queryParamOption(key)
+}
@@ -47,6 +47,9 @@ func (b *uiBuilder) TextInput(label string, opts ...textinput.Option) string {
 			ID:    widgetID,
 			Value: textInputOpts.DefaultValue,
 		}
+		if v, ok := b.lookupQueryParam(textInputOpts.QueryParam); ok {
+			textInputState.Value = &v[0]
+		}
 	}
 	textInputState.Label = textInputOpts.Label
 	textInputState.Placeholder = textInputOpts.Placeholder
@@ -57,6 +60,9 @@ func (b *uiBuilder) TextInput(label string, opts ...textinput.Option) string {
 	textInputState.MinLength = textInputOpts.MinLength
 	sess.State.Set(widgetID, textInputState)
 
+	value := ptrconv.StringValue(textInputState.Value)
+	b.bindQueryParam(textInputOpts.QueryParam, []string{value}, value == ptrconv.StringValue(textInputOpts.DefaultValue))
+
 	textInput := convertStateToTextInputProto(textInputState)
 	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
 		SessionId: sess.ID.String(),
@@ -72,7 +78,7 @@ func (b *uiBuilder) TextInput(label string, opts ...textinput.Option) string {
 
 	cursor.next()
 
-	return ptrconv.StringValue(textInputState.Value)
+	return value
 }
 
 func convertStateToTextInputProto(state *state.TextInputState) *widgetv1.TextInput {
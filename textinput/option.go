@@ -65,3 +65,15 @@ func (m minLengthOption) Apply(opts *options.TextInputOptions) {
 func WithMinLength(length int32) Option {
 	return minLengthOption(length)
 }
+
+type queryParamOption string
+
+func (q queryParamOption) Apply(opts *options.TextInputOptions) {
+	opts.QueryParam = string(q)
+}
+
+// WithQueryParam binds the value to the key query parameter of the page URL.
+// The parameter sets the initial value and is updated when the value changes.
+func WithQueryParam(key string) Option {
+	return queryParamOption(key)
+}
@@ -2,6 +2,7 @@ package sourcetool
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
@@ -21,7 +22,7 @@ func (b *uiBuilder) TimeInput(label string, opts ...timeinput.Option) *time.Time
 		DefaultValue: nil,
 		Required:     false,
 		Disabled:     false,
-		Location:     time.Local,
+		Location:     b.location(),
 	}
 
 	for _, o := range opts {
@@ -49,6 +50,11 @@ func (b *uiBuilder) TimeInput(label string, opts ...timeinput.Option) *time.Time
 			ID:    widgetID,
 			Value: timeInputOpts.DefaultValue,
 		}
+		if v, ok := b.lookupQueryParam(timeInputOpts.QueryParam); ok {
+			if t, err := time.ParseInLocation(time.TimeOnly, v[0], timeInputOpts.Location); err == nil {
+				timeInputState.Value = &t
+			}
+		}
 	}
 	timeInputState.Label = timeInputOpts.Label
 	timeInputState.Placeholder = timeInputOpts.Placeholder
@@ -58,6 +64,9 @@ func (b *uiBuilder) TimeInput(label string, opts ...timeinput.Option) *time.Time
 	timeInputState.Location = timeInputOpts.Location
 	sess.State.Set(widgetID, timeInputState)
 
+	values := formatTimeParam(timeInputState.Value, time.TimeOnly, timeInputOpts.Location)
+	b.bindQueryParam(timeInputOpts.QueryParam, values, slices.Equal(values, formatTimeParam(timeInputOpts.DefaultValue, time.TimeOnly, timeInputOpts.Location)))
+
 	timeInput := convertStateToTimeInputProto(timeInputState)
 	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
 		SessionId: sess.ID.String(),
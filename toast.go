@@ -0,0 +1,32 @@
+package sourcetool
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/alert"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+)
+
+// Toast sends a transient message to the session. It takes no slot in the
+// layout and keeps no state, so each call shows exactly one toast and a
+// rerun shows it again only if it calls Toast again.
+func (b *uiBuilder) Toast(message string, kind alert.Kind, duration time.Duration) {
+	sess := b.session
+	if sess == nil {
+		return
+	}
+	page := b.page
+	if page == nil {
+		return
+	}
+
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.Toast{
+		SessionId:  sess.ID.String(),
+		PageId:     page.id.String(),
+		Message:    message,
+		Kind:       kind.String(),
+		DurationMs: duration.Milliseconds(),
+	})
+}
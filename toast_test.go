@@ -0,0 +1,116 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/alert"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+)
+
+func TestToast(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	builder.Toast("Saved!", alert.KindSuccess, 3*time.Second)
+
+	messages := mockWS.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("WebSocket messages count = %d, want 1", len(messages))
+	}
+	toast := messages[0].GetToast()
+	if toast == nil {
+		t.Fatal("WebSocket message type = nil, want Toast")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"SessionId", toast.SessionId, sessionID.String()},
+		{"PageId", toast.PageId, pageID.String()},
+		{"Message", toast.Message, "Saved!"},
+		{"Kind", toast.Kind, alert.KindSuccess.String()},
+		{"DurationMs", toast.DurationMs, int64(3000)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+
+	if path := builder.cursor.getPath(); path[0] != 0 {
+		t.Errorf("cursor path after Toast = %v, want [0]", path)
+	}
+}
+
+func TestToast_OncePerCall(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	countToasts := func() int {
+		n := 0
+		for _, msg := range mockWS.Messages() {
+			if msg.GetToast() != nil {
+				n++
+			}
+		}
+		return n
+	}
+	run := func(saveClicked bool) {
+		builder.cursor = newCursor()
+		if saveClicked {
+			builder.Toast("Saved!", alert.KindSuccess, 3*time.Second)
+		}
+	}
+
+	// Clicking Save twice in a row shows the toast twice.
+	run(true)
+	run(true)
+	if n := countToasts(); n != 2 {
+		t.Errorf("toasts sent after two clicks = %d, want 2", n)
+	}
+
+	// A rerun that does not call Toast shows nothing.
+	run(false)
+	if n := countToasts(); n != 2 {
+		t.Errorf("toasts sent after a rerun without Toast = %d, want 2", n)
+	}
+}
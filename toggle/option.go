@@ -0,0 +1,27 @@
+package toggle
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.ToggleOptions)
+}
+
+type defaultValueOption bool
+
+func (d defaultValueOption) Apply(opts *options.ToggleOptions) {
+	opts.DefaultValue = bool(d)
+}
+
+func WithDefaultValue(defaultValue bool) Option {
+	return defaultValueOption(defaultValue)
+}
+
+type disabledOption bool
+
+func (d disabledOption) Apply(opts *options.ToggleOptions) {
+	opts.Disabled = bool(d)
+}
+
+func WithDisabled(disabled bool) Option {
+	return disabledOption(disabled)
+}
@@ -3,29 +3,48 @@ package sourcetool
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gofrs/uuid/v5"
 
+	"github.com/trysourcetool/sourcetool-go/alert"
+	"github.com/trysourcetool/sourcetool-go/audio"
 	"github.com/trysourcetool/sourcetool-go/button"
 	"github.com/trysourcetool/sourcetool-go/checkbox"
 	"github.com/trysourcetool/sourcetool-go/checkboxgroup"
+	"github.com/trysourcetool/sourcetool-go/code"
+	"github.com/trysourcetool/sourcetool-go/colorpicker"
 	"github.com/trysourcetool/sourcetool-go/columns"
 	"github.com/trysourcetool/sourcetool-go/dateinput"
+	"github.com/trysourcetool/sourcetool-go/daterangeinput"
+	"github.com/trysourcetool/sourcetool-go/daterangeslider"
 	"github.com/trysourcetool/sourcetool-go/datetimeinput"
+	"github.com/trysourcetool/sourcetool-go/downloadbutton"
+	"github.com/trysourcetool/sourcetool-go/fileuploader"
 	"github.com/trysourcetool/sourcetool-go/form"
+	"github.com/trysourcetool/sourcetool-go/image"
 	"github.com/trysourcetool/sourcetool-go/internal/session"
 	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/json"
+	"github.com/trysourcetool/sourcetool-go/metric"
 	"github.com/trysourcetool/sourcetool-go/multiselect"
+	"github.com/trysourcetool/sourcetool-go/navigate"
 	"github.com/trysourcetool/sourcetool-go/numberinput"
 	"github.com/trysourcetool/sourcetool-go/radio"
+	"github.com/trysourcetool/sourcetool-go/rangeslider"
+	"github.com/trysourcetool/sourcetool-go/rating"
 	"github.com/trysourcetool/sourcetool-go/selectbox"
+	"github.com/trysourcetool/sourcetool-go/slider"
 	"github.com/trysourcetool/sourcetool-go/table"
 	"github.com/trysourcetool/sourcetool-go/textarea"
 	"github.com/trysourcetool/sourcetool-go/textinput"
 	"github.com/trysourcetool/sourcetool-go/timeinput"
+	"github.com/trysourcetool/sourcetool-go/toggle"
+	"github.com/trysourcetool/sourcetool-go/video"
 )
 
 type UIBuilder interface {
@@ -46,6 +65,34 @@ type UIBuilder interface {
 	Button(string, ...button.Option) bool
 	Form(string, ...form.Option) (UIBuilder, bool)
 	Columns(int, ...columns.Option) []UIBuilder
+	Alert(alert.Kind, string, ...alert.Option)
+	Info(string, ...alert.Option)
+	Success(string, ...alert.Option)
+	Warning(string, ...alert.Option)
+	Error(string, ...alert.Option)
+	Toast(string, alert.Kind, time.Duration)
+	Metric(string, float64, ...metric.Option)
+	Progress(string) *Progress
+	Spinner(string, func(*Spinner) error) error
+	Empty() Slot
+	FileUploader(string, ...fileuploader.Option) []*fileuploader.UploadedFile
+	DownloadButton(string, string, string, func(io.Writer) error, ...downloadbutton.Option) error
+	Image(any, ...image.Option) error
+	Video(any, ...video.Option) error
+	Audio(any, ...audio.Option) error
+	Code(string, string, ...code.Option)
+	JSON(any, ...json.Option) error
+	Slider(string, ...slider.Option) float64
+	RangeSlider(string, ...rangeslider.Option) (float64, float64)
+	DateRangeSlider(string, ...daterangeslider.Option) [2]time.Time
+	DateRangeInput(string, ...daterangeinput.Option) (*time.Time, *time.Time)
+	Toggle(string, ...toggle.Option) bool
+	ColorPicker(string, ...colorpicker.Option) string
+	Rating(string, int, ...rating.Option) int
+	Params() map[string]string
+	QueryParams() url.Values
+	SetQueryParams(url.Values)
+	Navigate(string, map[string]string, ...navigate.Option) error
 }
 
 type uiBuilder struct {
@@ -60,6 +107,25 @@ func (b *uiBuilder) Context() context.Context {
 	return b.context
 }
 
+// runFinished reports whether the page run that created b has returned.
+// The runtime cancels the run context as soon as the handler returns.
+func (b *uiBuilder) runFinished() bool {
+	return b.context != nil && b.context.Err() != nil
+}
+
+// Params returns the route parameters of the current page, such as "id" for
+// a page registered at "/customers/:id".
+func (b *uiBuilder) Params() map[string]string {
+	params := make(map[string]string)
+	if b.session == nil {
+		return params
+	}
+	for k, v := range b.session.Params {
+		params[k] = v
+	}
+	return params
+}
+
 func (b *uiBuilder) generatePageID(widgetType state.WidgetType, path []int) uuid.UUID {
 	if b.page == nil {
 		return uuid.Nil
@@ -71,6 +137,14 @@ func (b *uiBuilder) generatePageID(widgetType state.WidgetType, path []int) uuid
 	return uuid.NewV5(b.page.id, widgetType.String()+"-"+strings.Join(strPath, "_"))
 }
 
+// location returns the default time zone of date and time widgets.
+func (b *uiBuilder) location() *time.Location {
+	if b.runtime == nil || b.runtime.location == nil {
+		return time.Local
+	}
+	return b.runtime.location
+}
+
 type path []int
 
 func (p path) String() string {
@@ -84,6 +158,8 @@ func (p path) String() string {
 type cursor struct {
 	parentPath []int
 	index      int
+	// pinned cursors always point at the same path; see Slot.
+	pinned bool
 }
 
 func newCursor() *cursor {
@@ -101,5 +177,8 @@ func (c *cursor) getPath() path {
 }
 
 func (c *cursor) next() {
+	if c.pinned {
+		return
+	}
 	c.index++
 }
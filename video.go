@@ -0,0 +1,112 @@
+package sourcetool
+
+import (
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/trysourcetool/sourcetool-go/internal/options"
+	websocketv1 "github.com/trysourcetool/sourcetool-go/internal/pb/websocket/v1"
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/video"
+)
+
+func (b *uiBuilder) Video(src any, opts ...video.Option) error {
+	videoOpts := &options.VideoOptions{
+		Caption:  "",
+		Width:    nil,
+		MimeType: "",
+		Autoplay: false,
+		Loop:     false,
+		Muted:    false,
+	}
+
+	for _, o := range opts {
+		o.Apply(videoOpts)
+	}
+
+	sess := b.session
+	if sess == nil {
+		return nil
+	}
+	page := b.page
+	if page == nil {
+		return nil
+	}
+	cursor := b.cursor
+	if cursor == nil {
+		return nil
+	}
+	path := cursor.getPath()
+
+	url, mediaID, mimeType, err := b.resolveMediaSource(src, videoOpts.MimeType)
+	if err != nil {
+		return err
+	}
+
+	widgetID := b.generatePageID(state.WidgetTypeVideo, path)
+	videoState := sess.State.GetVideo(widgetID)
+	if videoState == nil {
+		videoState = &state.VideoState{
+			ID: widgetID,
+		}
+	}
+	videoState.Src = url
+	videoState.MediaID = mediaID
+	videoState.MimeType = mimeType
+	videoState.Caption = videoOpts.Caption
+	videoState.Width = videoOpts.Width
+	videoState.Autoplay = videoOpts.Autoplay
+	videoState.Loop = videoOpts.Loop
+	videoState.Muted = videoOpts.Muted
+	sess.State.Set(widgetID, videoState)
+
+	videoProto := convertStateToVideoProto(videoState)
+	b.runtime.wsClient.Enqueue(uuid.Must(uuid.NewV4()).String(), &websocketv1.RenderWidget{
+		SessionId: sess.ID.String(),
+		PageId:    page.id.String(),
+		Path:      convertPathToInt32Slice(path),
+		Widget: &widgetv1.Widget{
+			Id: widgetID.String(),
+			Type: &widgetv1.Widget_Video{
+				Video: videoProto,
+			},
+		},
+	})
+
+	cursor.next()
+
+	return nil
+}
+
+func convertStateToVideoProto(state *state.VideoState) *widgetv1.Video {
+	if state == nil {
+		return nil
+	}
+	return &widgetv1.Video{
+		Src:      state.Src,
+		MediaId:  state.MediaID,
+		MimeType: state.MimeType,
+		Caption:  state.Caption,
+		Width:    state.Width,
+		Autoplay: state.Autoplay,
+		Loop:     state.Loop,
+		Muted:    state.Muted,
+	}
+}
+
+func convertVideoProtoToState(id uuid.UUID, data *widgetv1.Video) *state.VideoState {
+	if data == nil {
+		return nil
+	}
+	return &state.VideoState{
+		ID:       id,
+		Src:      data.Src,
+		MediaID:  data.MediaId,
+		MimeType: data.MimeType,
+		Caption:  data.Caption,
+		Width:    data.Width,
+		Autoplay: data.Autoplay,
+		Loop:     data.Loop,
+		Muted:    data.Muted,
+	}
+}
@@ -0,0 +1,67 @@
+package video
+
+import "github.com/trysourcetool/sourcetool-go/internal/options"
+
+type Option interface {
+	Apply(*options.VideoOptions)
+}
+
+type captionOption string
+
+func (c captionOption) Apply(opts *options.VideoOptions) {
+	opts.Caption = string(c)
+}
+
+func WithCaption(caption string) Option {
+	return captionOption(caption)
+}
+
+type widthOption int32
+
+func (w widthOption) Apply(opts *options.VideoOptions) {
+	opts.Width = (*int32)(&w)
+}
+
+func WithWidth(width int32) Option {
+	return widthOption(width)
+}
+
+type mimeTypeOption string
+
+func (m mimeTypeOption) Apply(opts *options.VideoOptions) {
+	opts.MimeType = string(m)
+}
+
+func WithMimeType(mimeType string) Option {
+	return mimeTypeOption(mimeType)
+}
+
+type autoplayOption bool
+
+func (a autoplayOption) Apply(opts *options.VideoOptions) {
+	opts.Autoplay = bool(a)
+}
+
+func WithAutoplay(autoplay bool) Option {
+	return autoplayOption(autoplay)
+}
+
+type loopOption bool
+
+func (l loopOption) Apply(opts *options.VideoOptions) {
+	opts.Loop = bool(l)
+}
+
+func WithLoop(loop bool) Option {
+	return loopOption(loop)
+}
+
+type mutedOption bool
+
+func (m mutedOption) Apply(opts *options.VideoOptions) {
+	opts.Muted = bool(m)
+}
+
+func WithMuted(muted bool) Option {
+	return mutedOption(muted)
+}
@@ -0,0 +1,153 @@
+package sourcetool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+
+	widgetv1 "github.com/trysourcetool/sourcetool-go/internal/pb/widget/v1"
+	"github.com/trysourcetool/sourcetool-go/internal/session"
+	"github.com/trysourcetool/sourcetool-go/internal/session/state"
+	"github.com/trysourcetool/sourcetool-go/internal/websocket/mock"
+	"github.com/trysourcetool/sourcetool-go/video"
+)
+
+func TestConvertStateToVideoProto(t *testing.T) {
+	width := int32(640)
+	videoState := &state.VideoState{
+		ID:       uuid.Must(uuid.NewV4()),
+		Src:      "https://example.com/demo.mp4",
+		MimeType: "video/mp4",
+		Caption:  "Demo",
+		Width:    &width,
+		Autoplay: true,
+		Loop:     true,
+		Muted:    true,
+	}
+
+	data := convertStateToVideoProto(videoState)
+
+	if data == nil {
+		t.Fatal("convertStateToVideoProto returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Src", data.Src, videoState.Src},
+		{"MimeType", data.MimeType, videoState.MimeType},
+		{"Caption", data.Caption, videoState.Caption},
+		{"Width", data.GetWidth(), width},
+		{"Autoplay", data.Autoplay, videoState.Autoplay},
+		{"Loop", data.Loop, videoState.Loop},
+		{"Muted", data.Muted, videoState.Muted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertVideoProtoToState(t *testing.T) {
+	data := &widgetv1.Video{
+		MediaId:  "abc",
+		MimeType: "video/webm",
+		Caption:  "Demo",
+		Autoplay: true,
+	}
+
+	state := convertVideoProtoToState(uuid.Must(uuid.NewV4()), data)
+
+	if state == nil {
+		t.Fatal("convertVideoProtoToState returned nil")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"MediaID", state.MediaID, data.MediaId},
+		{"MimeType", state.MimeType, data.MimeType},
+		{"Caption", state.Caption, data.Caption},
+		{"Autoplay", state.Autoplay, data.Autoplay},
+		{"Width", state.Width == nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVideo(t *testing.T) {
+	sessionID := uuid.Must(uuid.NewV4())
+	pageID := uuid.Must(uuid.NewV4())
+	sess := session.New(sessionID, pageID)
+
+	mockWS := mock.NewClient()
+
+	builder := &uiBuilder{
+		context: context.Background(),
+		session: sess,
+		cursor:  newCursor(),
+		page: &page{
+			id: pageID,
+		},
+		runtime: &runtime{
+			wsClient: mockWS,
+		},
+	}
+
+	data := []byte("\x1a\x45\xdf\xa3webm-content")
+	if err := builder.Video(data, video.WithMimeType("video/webm"), video.WithLoop(true)); err != nil {
+		t.Fatalf("Video returned error: %v", err)
+	}
+
+	messages := mockWS.Messages()
+	if len(messages) != 2 {
+		t.Fatalf("WebSocket messages count = %d, want 2", len(messages))
+	}
+	if v := messages[0].GetMediaChunk(); v == nil {
+		t.Fatal("WebSocket message type = nil, want MediaChunk")
+	}
+	if v := messages[1].GetRenderWidget(); v == nil {
+		t.Fatal("WebSocket message type = nil, want RenderWidget")
+	}
+
+	widgetID := builder.generatePageID(state.WidgetTypeVideo, []int{0})
+	state := sess.State.GetVideo(widgetID)
+	if state == nil {
+		t.Fatal("Video state not found")
+	}
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"Src", state.Src, ""},
+		{"MediaID", state.MediaID, messages[0].GetMediaChunk().MediaId},
+		{"MimeType", state.MimeType, "video/webm"},
+		{"Loop", state.Loop, true},
+		{"Autoplay", state.Autoplay, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}